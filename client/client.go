@@ -0,0 +1,86 @@
+// Package client builds the Commerce Layer API client used by the terraform provider. It is exported so that other
+// Go programs, like operators or migration scripts, can talk to the API with exactly the same authentication and
+// transport setup as the provider.
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/incentro-dc/go-commercelayer-sdk/api"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+type Config struct {
+	// ClientId is the client id of a Commercelayer store
+	ClientId string
+	// ClientSecret is the client secret of a Commercelayer store
+	ClientSecret string
+	// ApiEndpoint is the Commercelayer api endpoint, e.g. https://<organization>.commercelayer.io/api
+	ApiEndpoint string
+	// AuthEndpoint is the Commercelayer auth endpoint, e.g. https://<organization>.commercelayer.io/oauth/token
+	AuthEndpoint string
+	// Scopes restricts the access token, e.g. to a single market with market:id:<market id>. They only apply to the
+	// client credentials flow, a TokenSource is used as is.
+	Scopes []string
+	// TokenSource overrides the client credentials flow when set, including its Scopes. This is mostly useful in
+	// tests.
+	TokenSource oauth2.TokenSource
+	// MaxRetries is the number of times a rate limited or failed request is retried, DefaultMaxRetries when zero.
+	// A negative value disables retries.
+	MaxRetries int
+	// Debug enables request and response dumps in the log
+	Debug bool
+}
+
+// New returns an api.APIClient authenticating through the OAuth2 client credentials flow. Tokens are fetched lazily
+// and refreshed when they expire. Requests that are rate limited, or that failed on the server side, are retried
+// honouring the Retry-After header.
+func New(cfg Config) *api.APIClient {
+	credentials := clientcredentials.Config{
+		ClientID:     cfg.ClientId,
+		ClientSecret: cfg.ClientSecret,
+		TokenURL:     cfg.AuthEndpoint,
+		Scopes:       cfg.Scopes,
+	}
+
+	// The token source outlives any request scoped context, so it is bound to the background context.
+	ctx := context.Background()
+
+	var tokenSource = credentials.TokenSource(ctx)
+	if cfg.TokenSource != nil {
+		tokenSource = cfg.TokenSource
+	}
+
+	var transport = http.DefaultTransport
+
+	maxRetries := cfg.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultMaxRetries
+	}
+	if maxRetries > 0 {
+		transport = &retryTransport{
+			base:       transport,
+			maxRetries: maxRetries,
+			backoff:    retryBackoff,
+		}
+	}
+
+	// The retries happen below the OAuth2 transport, which stays on top so that the token of the client can still
+	// be inspected, e.g. to check the mode of the credentials.
+	httpClient := &http.Client{
+		Transport: &oauth2.Transport{
+			Source: oauth2.ReuseTokenSource(nil, tokenSource),
+			Base:   transport,
+		},
+	}
+
+	return api.NewAPIClient(&api.Configuration{
+		HTTPClient: httpClient,
+		Debug:      cfg.Debug,
+		Servers: []api.ServerConfiguration{
+			{URL: cfg.ApiEndpoint},
+		},
+	})
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+func TestNewUsesTokenSource(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(`{"data": []}`))
+	}))
+	defer server.Close()
+
+	c := New(Config{
+		ApiEndpoint: server.URL,
+		TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "foobar"}),
+	})
+
+	_, _, err := c.MarketsApi.GETMarkets(context.Background()).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer foobar", authorization)
+}
+
+func TestNewRetriesRateLimitedRequests(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		assert.Equal(t, "Bearer foobar", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(`{"data": []}`))
+	}))
+	defer server.Close()
+
+	c := New(Config{
+		ApiEndpoint: server.URL,
+		TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "foobar"}),
+	})
+
+	_, _, err := c.MarketsApi.GETMarkets(context.Background()).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+func TestNewDisablesRetries(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	c := New(Config{
+		ApiEndpoint: server.URL,
+		TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "foobar"}),
+		MaxRetries:  -1,
+	})
+
+	_, _, err := c.MarketsApi.GETMarkets(context.Background()).Execute()
+	assert.Error(t, err)
+	assert.Equal(t, 1, requests)
+}
+
+func TestRetryTransport(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+string(body))
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{Transport: &retryTransport{
+		base:       http.DefaultTransport,
+		maxRetries: 2,
+		backoff:    time.Millisecond,
+	}}
+
+	resp, err := httpClient.Post(server.URL, "application/json", strings.NewReader("foo"))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, []string{"POST foo"}, requests)
+
+	requests = nil
+	req, _ := http.NewRequest(http.MethodPatch, server.URL, strings.NewReader("bar"))
+	resp, err = httpClient.Do(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, []string{"PATCH bar", "PATCH bar", "PATCH bar"}, requests)
+}
+
+func TestRetryTransportWait(t *testing.T) {
+	transport := &retryTransport{backoff: time.Second}
+
+	header := http.Header{}
+	assert.Equal(t, 4*time.Second, transport.wait(2, &http.Response{Header: header}))
+
+	header.Set("Retry-After", "7")
+	assert.Equal(t, 7*time.Second, transport.wait(0, &http.Response{Header: header}))
+
+	header.Set("Retry-After", "3600")
+	assert.Equal(t, maxRetryWait, transport.wait(0, &http.Response{Header: header}))
+
+	header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.Equal(t, time.Duration(0), transport.wait(0, &http.Response{Header: header}))
+}
+
+func TestNewKeepsOAuth2Transport(t *testing.T) {
+	c := New(Config{
+		TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "foobar"}),
+	})
+
+	transport, ok := c.GetConfig().HTTPClient.Transport.(*oauth2.Transport)
+	assert.True(t, ok)
+
+	token, err := transport.Source.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, "foobar", token.AccessToken)
+}
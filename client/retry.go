@@ -0,0 +1,108 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultMaxRetries is the number of times a request is retried when Config.MaxRetries is left at zero
+const DefaultMaxRetries = 3
+
+const (
+	// retryBackoff is the wait before the first retry when the API doesn't tell how long to wait, doubled on every
+	// further retry
+	retryBackoff = time.Second
+	// maxRetryWait caps the wait between two attempts, including the one asked for with Retry-After
+	maxRetryWait = time.Minute
+)
+
+// retryTransport retries requests that are rate limited (429) or that failed on the server side (5xx). Rate limited
+// requests have not been processed and are always retried, while server errors are only retried for requests that
+// don't create anything, so a POST is never sent twice. The Retry-After header is honoured when present, otherwise the
+// wait grows exponentially.
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	backoff    time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := t.base.RoundTrip(req)
+		if err != nil || attempt >= t.maxRetries || !t.retryable(req, resp) {
+			return resp, err
+		}
+
+		retry, err := rewind(req)
+		if err != nil {
+			return resp, nil
+		}
+
+		wait := t.wait(attempt, resp)
+
+		// The response is discarded, drain it so the connection can be reused
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		req = retry
+	}
+}
+
+func (t *retryTransport) retryable(req *http.Request, resp *http.Response) bool {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return resp.StatusCode >= 500 && req.Method != http.MethodPost
+}
+
+// wait returns how long to wait before the next attempt, as asked for by the Retry-After header in either seconds or
+// an HTTP date, or an exponential backoff otherwise
+func (t *retryTransport) wait(attempt int, resp *http.Response) time.Duration {
+	wait := time.Duration(float64(t.backoff) * math.Pow(2, float64(attempt)))
+
+	retryAfter := resp.Header.Get("Retry-After")
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(retryAfter); err == nil {
+		wait = time.Until(date)
+	}
+
+	if wait < 0 {
+		return 0
+	}
+	if wait > maxRetryWait {
+		return maxRetryWait
+	}
+	return wait
+}
+
+// rewind returns a copy of the request with a fresh body, so it can be sent again
+func rewind(req *http.Request) (*http.Request, error) {
+	retry := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return retry, nil
+	}
+	if req.GetBody == nil {
+		return nil, errors.New("request body can't be sent again")
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	retry.Body = body
+
+	return retry, nil
+}
@@ -0,0 +1,241 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceAddress() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "Look up an existing address by id, or by reference and/or business name. This is useful to " +
+			"attach addresses imported from an ERP to merchants and stock locations.",
+		ReadContext: dataSourceAddressReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:   "The address unique identifier",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"reference", "company"},
+				AtLeastOneOf:  []string{"id", "reference", "company"},
+			},
+		},
+	}
+
+	for key, value := range addressDataSourceSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	dataSource.Schema["reference"] = &schema.Schema{
+		Description:  "The external identifier of the address, e.g. its id in the ERP",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		AtLeastOneOf: []string{"id", "reference", "company"},
+	}
+	dataSource.Schema["company"] = &schema.Schema{
+		Description: "The company name of the business address. Exactly one address must match the given " +
+			"reference and company name.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		AtLeastOneOf: []string{"id", "reference", "company"},
+	}
+
+	return dataSource
+}
+
+func dataSourceAddressReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	var address *jsonApiResource
+	if id, ok := d.GetOk("id"); ok {
+		resource, err := getResource(ctx, c, "/addresses/"+id.(string), nil)
+		if err != nil {
+			return diagErr(err)
+		}
+		address = resource
+	} else {
+		query := url.Values{}
+		if reference, ok := d.GetOk("reference"); ok {
+			query.Set("filter[q][reference_eq]", reference.(string))
+		}
+		if company, ok := d.GetOk("company"); ok {
+			query.Set("filter[q][company_eq]", company.(string))
+		}
+
+		resource, err := findResource(ctx, c, "/addresses", query)
+		if err != nil {
+			return diagErr(err)
+		}
+		address = resource
+	}
+
+	values, err := flattenAddress(*address)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	d.SetId(address.Id)
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
+
+// flattenAddress returns the data source values of an address
+func flattenAddress(address jsonApiResource) (map[string]interface{}, error) {
+	var attributes commercelayer.GETAddresses200ResponseDataInnerAttributes
+	err := address.decodeAttributes(&attributes)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected address %s: %w", address.Id, err)
+	}
+
+	return map[string]interface{}{
+		"business":         attributes.GetBusiness(),
+		"first_name":       attributes.GetFirstName(),
+		"last_name":        attributes.GetLastName(),
+		"company":          attributes.GetCompany(),
+		"full_name":        attributes.GetFullName(),
+		"line_1":           attributes.GetLine1(),
+		"line_2":           attributes.GetLine2(),
+		"city":             attributes.GetCity(),
+		"zip_code":         attributes.GetZipCode(),
+		"state_code":       attributes.GetStateCode(),
+		"country_code":     attributes.GetCountryCode(),
+		"phone":            attributes.GetPhone(),
+		"full_address":     attributes.GetFullAddress(),
+		"email":            attributes.GetEmail(),
+		"notes":            attributes.GetNotes(),
+		"lat":              float64(attributes.GetLat()),
+		"lng":              float64(attributes.GetLng()),
+		"billing_info":     attributes.GetBillingInfo(),
+		"reference":        attributes.GetReference(),
+		"reference_origin": attributes.GetReferenceOrigin(),
+		"metadata":         stringMap(attributes.GetMetadata()),
+	}, nil
+}
+
+// addressDataSourceSchema returns the computed attributes of an address, shared by the address data source and the
+// addresses nested in other data sources
+func addressDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"business": {
+			Description: "Indicates if it's a business or a personal address",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"first_name": {
+			Description: "Address first name",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"last_name": {
+			Description: "Address last name",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"company": {
+			Description: "Address company name",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"full_name": {
+			Description: "The company name for business addresses, or the first and last name otherwise",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"line_1": {
+			Description: "Address line 1, i.e. Street address, PO Box",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"line_2": {
+			Description: "Address line 2, i.e. Apartment, Suite, Building",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"city": {
+			Description: "Address city",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"zip_code": {
+			Description: "ZIP or postal code",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"state_code": {
+			Description: "State, province or region code",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"country_code": {
+			Description: "The international 2-letter country code as defined by the ISO 3166-1 standard",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"phone": {
+			Description: "Phone number (including extension)",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"full_address": {
+			Description: "The compact representation of the address, on a single line",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"email": {
+			Description: "Email address",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"notes": {
+			Description: "A free notes attached to the address",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"lat": {
+			Description: "The address geocoded latitude",
+			Type:        schema.TypeFloat,
+			Computed:    true,
+		},
+		"lng": {
+			Description: "The address geocoded longitude",
+			Type:        schema.TypeFloat,
+			Computed:    true,
+		},
+		"billing_info": {
+			Description: "Customer's billing information (i.e. VAT number, codice fiscale)",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"reference": {
+			Description: "The external identifier of the address",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"reference_origin": {
+			Description: "The identifier of the third party system that defines the reference code",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"metadata": {
+			Description: "The key-value pairs attached to the address",
+			Type:        schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Computed: true,
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceAddress_basic() {
+	resourceName := "data.commercelayer_address.incentro_address"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAddressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccAddressCreate(resourceName),
+					testAccDataSourceAddress()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"commercelayer_address.incentro_address", "id"),
+					resource.TestCheckResourceAttr(resourceName, "business", "true"),
+					resource.TestCheckResourceAttr(resourceName, "city", "Rotterdam"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAddress() string {
+	return `
+		data "commercelayer_address" "incentro_address" {
+		  id = commercelayer_address.incentro_address.id
+		}
+	`
+}
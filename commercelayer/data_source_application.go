@@ -0,0 +1,128 @@
+package commercelayer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceApplication() *schema.Resource {
+	return &schema.Resource{
+		Description: "Introspect the application of the provider credentials: its kind, scope, mode and " +
+			"organization. This can be used with a postcondition to assert that a plan runs against the intended " +
+			"organization before mutating anything.",
+		ReadContext: dataSourceApplicationReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The application unique identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The application's internal name",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"kind": {
+				Description: "The application's kind, e.g. 'integration' or 'sales_channel'",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"public_access": {
+				Description: "Indicates if the application has public access",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"scopes": {
+				Description: "The scopes the application can request an access token for",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"scope": {
+				Description: "The scope of the access token in use, e.g. 'market:all'",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"mode": {
+				Description: "The mode the credentials have been issued for, either 'test' or 'live'",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"organization_id": {
+				Description: "The id of the organization the application belongs to",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"organization_slug": {
+				Description: "The slug of the organization the application belongs to",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference": {
+				Description: "The external identifier of the application",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the application",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceApplicationReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	claims, err := clientAccessTokenClaims(c)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	mode, err := claims.mode()
+	if err != nil {
+		return diagErr(err)
+	}
+
+	resp, _, err := c.ApplicationApi.GETApplicationApplicationId(ctx).Execute()
+	if err != nil {
+		return diagErr(err)
+	}
+
+	application := resp.GetData()
+	attributes := application.GetAttributes()
+
+	d.SetId(application.GetId())
+
+	values := map[string]interface{}{
+		"name":              attributes.GetName(),
+		"kind":              attributes.GetKind(),
+		"public_access":     attributes.GetPublicAccess(),
+		"scopes":            attributes.GetScopes(),
+		"scope":             claims.Scope,
+		"mode":              mode,
+		"organization_id":   claims.Organization.Id,
+		"organization_slug": claims.Organization.Slug,
+		"reference":         attributes.GetReference(),
+		"reference_origin":  attributes.GetReferenceOrigin(),
+		"metadata":          stringMap(attributes.GetMetadata()),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceApplicationRead(t *testing.T) {
+	c := testTokenClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/application", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"id": "GdqZKiYqEn",
+				"type": "applications",
+				"attributes": {"name": "Terraform", "kind": "integration", "scopes": "market:all"}
+			}
+		}`))
+	}, `{
+		"organization": {"id": "WXlEOFrjnr", "slug": "incentro"},
+		"application": {"id": "GdqZKiYqEn", "kind": "integration", "public": false},
+		"scope": "market:all",
+		"test": false
+	}`)
+	d := schema.TestResourceDataRaw(t, dataSourceApplication().Schema, map[string]interface{}{})
+
+	diags := dataSourceApplicationReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "GdqZKiYqEn", d.Id())
+	assert.Equal(t, "integration", d.Get("kind"))
+	assert.Equal(t, "market:all", d.Get("scope"))
+	assert.Equal(t, "live", d.Get("mode"))
+	assert.Equal(t, "incentro", d.Get("organization_slug"))
+}
@@ -0,0 +1,167 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceAttachments() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "List the attachments of an attachable resource, e.g. a SKU or a market. This is useful to " +
+			"verify that required documents, such as manuals or compliance certificates, exist before an apply.",
+		ReadContext: dataSourceAttachmentsReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The identifier of the applied filters",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filter": queryFilterSchema(),
+			"attachable_id": {
+				Description: "The id of the resource to list the attachments of",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name_prefix": {
+				Description: "Only list the attachments whose name starts with this prefix",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"names": {
+				Description: "The names of the matching attachments, sorted",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"attachments": {
+				Description: "The matching attachments, sorted by name",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The attachment unique identifier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The internal name of the attachment",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "An internal description of the attachment",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"url": {
+							Description: "The URL of the attachment",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"attachable_type": {
+							Description: "The type of the resource the attachment belongs to, e.g. 'skus'",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference": {
+							Description: "The external identifier of the attachment",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference_origin": {
+							Description: "The identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"metadata": {
+							Description: "The key-value pairs attached to the attachment",
+							Type:        schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for key, value := range queryPaginationSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourceAttachmentsReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	filters := url.Values{
+		"filter[q][attachable_id_eq]": {d.Get("attachable_id").(string)},
+	}
+	if namePrefix, ok := d.GetOk("name_prefix"); ok {
+		filters.Set("filter[q][name_start]", namePrefix.(string))
+	}
+
+	if err := expandQueryFilters(d, filters); err != nil {
+		return diagErr(err)
+	}
+
+	query := url.Values{
+		"include": {"attachable"},
+		"sort":    {"name"},
+	}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	resources, truncated, err := listDataSourceResources(ctx, c, d, "/attachments", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	names := make([]interface{}, 0, len(resources))
+	attachments := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		var attributes commercelayer.GETAttachments200ResponseDataInnerAttributes
+		err := resource.decodeAttributes(&attributes)
+		if err != nil {
+			return diag.Errorf("unexpected attachment %s: %s", resource.Id, err)
+		}
+
+		names = append(names, attributes.GetName())
+		attachments = append(attachments, map[string]interface{}{
+			"id":               resource.Id,
+			"name":             attributes.GetName(),
+			"description":      attributes.GetDescription(),
+			"url":              attributes.GetUrl(),
+			"attachable_type":  resource.relationship("attachable").Type,
+			"reference":        attributes.GetReference(),
+			"reference_origin": attributes.GetReferenceOrigin(),
+			"metadata":         stringMap(attributes.GetMetadata()),
+		})
+	}
+
+	d.SetId(queryId(filters))
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diagErr(err)
+	}
+
+	if err := d.Set("names", names); err != nil {
+		return diagErr(err)
+	}
+	if err := d.Set("attachments", attachments); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceAttachmentsRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourceAttachments(), map[string]interface{}{
+		"attachable_id": "sku-tshirt",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/attachments", r.URL.Path)
+		assert.Equal(t, "sku-tshirt", r.URL.Query().Get("filter[q][attachable_id_eq]"))
+		assert.Equal(t, "name", r.URL.Query().Get("sort"))
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "attachment-ce",
+				"type": "attachments",
+				"attributes": {"name": "CE certificate", "url": "https://example.com/ce.pdf"},
+				"relationships": {"attachable": {"data": {"id": "sku-tshirt", "type": "skus"}}}
+			}, {
+				"id": "attachment-manual",
+				"type": "attachments",
+				"attributes": {"name": "Manual", "url": "https://example.com/manual.pdf"},
+				"relationships": {"attachable": {"data": {"id": "sku-tshirt", "type": "skus"}}}
+			}],
+			"meta": {"record_count": 2, "page_count": 1}
+		}`))
+	})
+
+	diags := dataSourceAttachmentsReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []interface{}{"CE certificate", "Manual"}, d.Get("names"))
+	assert.Equal(t, "skus", d.Get("attachments.0.attachable_type"))
+	assert.Equal(t, "https://example.com/manual.pdf", d.Get("attachments.1.url"))
+}
@@ -0,0 +1,203 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceBundles() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "List the bundles, optionally filtered by code and market. This is useful to target bundles " +
+			"that are not managed by terraform, e.g. from promotions.",
+		ReadContext: dataSourceBundlesReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The identifier of the applied filters",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filter": queryFilterSchema(),
+			"code": {
+				Description: "Only list the bundle with this code, in each market",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"market_id": {
+				Description: "Only list the bundles of this market",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"bundles": {
+				Description: "The matching bundles, sorted by code",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The bundle unique identifier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"code": {
+							Description: "The bundle code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The internal name of the bundle",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "An internal description of the bundle",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"image_url": {
+							Description: "The URL of an image that represents the bundle",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"currency_code": {
+							Description: "The international 3-letter currency code as defined by the ISO 4217 standard",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"price_amount_cents": {
+							Description: "The bundle price amount, in cents",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"compare_at_amount_cents": {
+							Description: "The compared price amount, in cents, useful to display a discount",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"skus_count": {
+							Description: "The number of SKUs in the bundle",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"do_not_ship": {
+							Description: "Indicates if the bundle doesn't generate shipments",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"do_not_track": {
+							Description: "Indicates if the bundle doesn't track the stock inventory",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"market_id": {
+							Description: "The associated market id, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"sku_list_id": {
+							Description: "The associated SKU list id",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference": {
+							Description: "The external identifier of the bundle",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference_origin": {
+							Description: "The identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"metadata": {
+							Description: "The key-value pairs attached to the bundle",
+							Type:        schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for key, value := range queryPaginationSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourceBundlesReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	filters := url.Values{}
+	if code, ok := d.GetOk("code"); ok {
+		filters.Set("filter[q][code_eq]", code.(string))
+	}
+	if marketId, ok := d.GetOk("market_id"); ok {
+		filters.Set("filter[q][market_id_eq]", marketId.(string))
+	}
+
+	if err := expandQueryFilters(d, filters); err != nil {
+		return diagErr(err)
+	}
+
+	query := url.Values{
+		"include": {"market,sku_list"},
+		"sort":    {"code"},
+	}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	resources, truncated, err := listDataSourceResources(ctx, c, d, "/bundles", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	bundles := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		var attributes commercelayer.GETBundles200ResponseDataInnerAttributes
+		err := resource.decodeAttributes(&attributes)
+		if err != nil {
+			return diag.Errorf("unexpected bundle %s: %s", resource.Id, err)
+		}
+
+		bundles = append(bundles, map[string]interface{}{
+			"id":                      resource.Id,
+			"code":                    attributes.GetCode(),
+			"name":                    attributes.GetName(),
+			"description":             attributes.GetDescription(),
+			"image_url":               attributes.GetImageUrl(),
+			"currency_code":           attributes.GetCurrencyCode(),
+			"price_amount_cents":      int(attributes.GetPriceAmountCents()),
+			"compare_at_amount_cents": int(attributes.GetCompareAtAmountCents()),
+			"skus_count":              int(attributes.GetSkusCount()),
+			"do_not_ship":             attributes.GetDoNotShip(),
+			"do_not_track":            attributes.GetDoNotTrack(),
+			"market_id":               resource.relationship("market").Id,
+			"sku_list_id":             resource.relationship("sku_list").Id,
+			"reference":               attributes.GetReference(),
+			"reference_origin":        attributes.GetReferenceOrigin(),
+			"metadata":                stringMap(attributes.GetMetadata()),
+		})
+	}
+
+	d.SetId(queryId(filters))
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diagErr(err)
+	}
+
+	if err := d.Set("bundles", bundles); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
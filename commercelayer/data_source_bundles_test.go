@@ -0,0 +1,39 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceBundlesRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourceBundles(), map[string]interface{}{
+		"code": "BUNDLE-TSHIRTS",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/bundles", r.URL.Path)
+		assert.Equal(t, "BUNDLE-TSHIRTS", r.URL.Query().Get("filter[q][code_eq]"))
+		assert.Equal(t, "market,sku_list", r.URL.Query().Get("include"))
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "bundle-nl",
+				"type": "bundles",
+				"attributes": {"code": "BUNDLE-TSHIRTS", "name": "T-shirts", "currency_code": "EUR", "price_amount_cents": 4500, "skus_count": 3},
+				"relationships": {
+					"market": {"data": {"id": "market-nl", "type": "markets"}},
+					"sku_list": {"data": {"id": "sku-list-tshirts", "type": "sku_lists"}}
+				}
+			}],
+			"meta": {"record_count": 1, "page_count": 1}
+		}`))
+	})
+
+	diags := dataSourceBundlesReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, d.Get("bundles.#"))
+	assert.Equal(t, 4500, d.Get("bundles.0.price_amount_cents"))
+	assert.Equal(t, "market-nl", d.Get("bundles.0.market_id"))
+	assert.Equal(t, "sku-list-tshirts", d.Get("bundles.0.sku_list_id"))
+}
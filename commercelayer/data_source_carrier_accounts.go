@@ -0,0 +1,156 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceCarrierAccounts() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "List the carrier accounts, optionally filtered by market and carrier type. The credentials of " +
+			"the carrier accounts are never returned. This is useful to attach shipping methods to carriers that " +
+			"are configured outside of terraform.",
+		ReadContext: dataSourceCarrierAccountsReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The identifier of the applied filters",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filter": queryFilterSchema(),
+			"market_id": {
+				Description: "Only list the carrier accounts of this market",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"easypost_type": {
+				Description: "Only list the carrier accounts of this Easypost carrier type, e.g. 'DhlExpressAccount'",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"carrier_accounts": {
+				Description: "The matching carrier accounts, sorted by name",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The carrier account unique identifier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The carrier account internal name",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"easypost_type": {
+							Description: "The Easypost carrier type",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"easypost_id": {
+							Description: "The Easypost internal reference ID",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"market_id": {
+							Description: "The associated market id, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference": {
+							Description: "The external identifier of the carrier account",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference_origin": {
+							Description: "The identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"metadata": {
+							Description: "The key-value pairs attached to the carrier account",
+							Type:        schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for key, value := range queryPaginationSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourceCarrierAccountsReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	filters := url.Values{}
+	if marketId, ok := d.GetOk("market_id"); ok {
+		filters.Set("filter[q][market_id_eq]", marketId.(string))
+	}
+	if easypostType, ok := d.GetOk("easypost_type"); ok {
+		filters.Set("filter[q][easypost_type_eq]", easypostType.(string))
+	}
+
+	if err := expandQueryFilters(d, filters); err != nil {
+		return diagErr(err)
+	}
+
+	query := url.Values{
+		"include": {"market"},
+		"sort":    {"name"},
+	}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	resources, truncated, err := listDataSourceResources(ctx, c, d, "/carrier_accounts", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	carrierAccounts := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		var attributes commercelayer.GETCarrierAccounts200ResponseDataInnerAttributes
+		err := resource.decodeAttributes(&attributes)
+		if err != nil {
+			return diag.Errorf("unexpected carrier account %s: %s", resource.Id, err)
+		}
+
+		carrierAccounts = append(carrierAccounts, map[string]interface{}{
+			"id":               resource.Id,
+			"name":             attributes.GetName(),
+			"easypost_type":    attributes.GetEasypostType(),
+			"easypost_id":      attributes.GetEasypostId(),
+			"market_id":        resource.relationship("market").Id,
+			"reference":        attributes.GetReference(),
+			"reference_origin": attributes.GetReferenceOrigin(),
+			"metadata":         stringMap(attributes.GetMetadata()),
+		})
+	}
+
+	d.SetId(queryId(filters))
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diagErr(err)
+	}
+
+	if err := d.Set("carrier_accounts", carrierAccounts); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
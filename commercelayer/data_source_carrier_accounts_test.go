@@ -0,0 +1,34 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceCarrierAccountsRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourceCarrierAccounts(), map[string]interface{}{
+		"market_id": "market-nl",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/carrier_accounts", r.URL.Path)
+		assert.Equal(t, "market-nl", r.URL.Query().Get("filter[q][market_id_eq]"))
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "carrier-account-dhl",
+				"type": "carrier_accounts",
+				"attributes": {"name": "DHL Express", "easypost_type": "DhlExpressAccount", "easypost_id": "ca_123"},
+				"relationships": {"market": {"data": {"id": "market-nl", "type": "markets"}}}
+			}],
+			"meta": {"record_count": 1, "page_count": 1}
+		}`))
+	})
+
+	diags := dataSourceCarrierAccountsReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, d.Get("carrier_accounts.#"))
+	assert.Equal(t, "DhlExpressAccount", d.Get("carrier_accounts.0.easypost_type"))
+	assert.Equal(t, "market-nl", d.Get("carrier_accounts.0.market_id"))
+}
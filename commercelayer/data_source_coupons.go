@@ -0,0 +1,170 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceCoupons() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "List the coupons of a coupon codes promotion rule, walking through all the pages of results. " +
+			"This is useful to drive campaign reporting and cleanup automation from terraform.",
+		ReadContext: dataSourceCouponsReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The identifier of the applied filters",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filter": queryFilterSchema(),
+			"promotion_rule_id": {
+				Description: "The id of the coupon codes promotion rule to list the coupons of",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"code_prefix": {
+				Description: "Only list the coupons whose code starts with this prefix",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"codes": {
+				Description: "The codes of the matching coupons, sorted",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"coupons": {
+				Description: "The matching coupons, sorted by code",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The coupon unique identifier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"code": {
+							Description: "The coupon code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"customer_single_use": {
+							Description: "Indicates if the coupon can be used only once per customer",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"usage_limit": {
+							Description: "The total number of times the coupon can be used",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"usage_count": {
+							Description: "The number of times the coupon has been used",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"recipient_email": {
+							Description: "The email address of the coupon recipient, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference": {
+							Description: "The external identifier of the coupon",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference_origin": {
+							Description: "The identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"metadata": {
+							Description: "The key-value pairs attached to the coupon",
+							Type:        schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for key, value := range queryPaginationSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourceCouponsReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	filters := url.Values{
+		"filter[q][promotion_rule_id_eq]": {d.Get("promotion_rule_id").(string)},
+	}
+	if codePrefix, ok := d.GetOk("code_prefix"); ok {
+		filters.Set("filter[q][code_start]", codePrefix.(string))
+	}
+
+	if err := expandQueryFilters(d, filters); err != nil {
+		return diagErr(err)
+	}
+
+	query := url.Values{"sort": {"code"}}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	resources, truncated, err := listDataSourceResources(ctx, c, d, "/coupons", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	codes := make([]interface{}, 0, len(resources))
+	coupons := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		var attributes commercelayer.GETCoupons200ResponseDataInnerAttributes
+		err := resource.decodeAttributes(&attributes)
+		if err != nil {
+			return diag.Errorf("unexpected coupon %s: %s", resource.Id, err)
+		}
+
+		codes = append(codes, attributes.GetCode())
+		coupons = append(coupons, map[string]interface{}{
+			"id":                  resource.Id,
+			"code":                attributes.GetCode(),
+			"customer_single_use": attributes.GetCustomerSingleUse(),
+			"usage_limit":         int(attributes.GetUsageLimit()),
+			"usage_count":         int(attributes.GetUsageCount()),
+			"recipient_email":     attributes.GetRecipientEmail(),
+			"reference":           attributes.GetReference(),
+			"reference_origin":    attributes.GetReferenceOrigin(),
+			"metadata":            stringMap(attributes.GetMetadata()),
+		})
+	}
+
+	d.SetId(queryId(filters))
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diagErr(err)
+	}
+
+	if err := d.Set("codes", codes); err != nil {
+		return diagErr(err)
+	}
+	if err := d.Set("coupons", coupons); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
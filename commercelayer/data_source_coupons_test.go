@@ -0,0 +1,37 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceCouponsRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourceCoupons(), map[string]interface{}{
+		"promotion_rule_id": "qNbZpRvWxA",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/coupons", r.URL.Path)
+		assert.Equal(t, "qNbZpRvWxA", r.URL.Query().Get("filter[q][promotion_rule_id_eq]"))
+		assert.Equal(t, "code", r.URL.Query().Get("sort"))
+
+		page := r.URL.Query().Get("page[number]")
+		_, _ = fmt.Fprintf(w, `{
+			"data": [{
+				"id": "coupon-%s",
+				"type": "coupons",
+				"attributes": {"code": "SUMMER-%s", "usage_limit": 1, "usage_count": 0}
+			}],
+			"meta": {"record_count": 3, "page_count": 3}
+		}`, page, page)
+	})
+
+	diags := dataSourceCouponsReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []interface{}{"SUMMER-1", "SUMMER-2", "SUMMER-3"}, d.Get("codes"))
+	assert.Equal(t, 3, d.Get("coupons.#"))
+	assert.Equal(t, "coupon-3", d.Get("coupons.2.id"))
+	assert.Equal(t, 1, d.Get("coupons.2.usage_limit"))
+}
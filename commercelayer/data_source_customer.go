@@ -0,0 +1,99 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceCustomer() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing customer by email. This is useful to wire the test customers of a sandbox " +
+			"organization into other resources, e.g. in-stock subscriptions.",
+		ReadContext: dataSourceCustomerReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The customer unique identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"email": {
+				Description: "The customer's email address",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"status": {
+				Description: "The customer's status, one of 'prospect', 'acquired' or 'repeat'",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"has_password": {
+				Description: "Indicates if the customer has a password, i.e. if it isn't a guest customer",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"customer_group_id": {
+				Description: "The associated customer group id, if any",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference": {
+				Description: "The external identifier of the customer",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the customer",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCustomerReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	customer, err := findResource(ctx, c, "/customers", url.Values{
+		"include":             {"customer_group"},
+		"filter[q][email_eq]": {d.Get("email").(string)},
+	})
+	if err != nil {
+		return diagErr(err)
+	}
+
+	var attributes commercelayer.GETCustomers200ResponseDataInnerAttributes
+	err = customer.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected customer %s: %s", customer.Id, err)
+	}
+
+	d.SetId(customer.Id)
+
+	values := map[string]interface{}{
+		"status":            attributes.GetStatus(),
+		"has_password":      attributes.GetHasPassword(),
+		"customer_group_id": customer.relationship("customer_group").Id,
+		"reference":         attributes.GetReference(),
+		"reference_origin":  attributes.GetReferenceOrigin(),
+		"metadata":          stringMap(attributes.GetMetadata()),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
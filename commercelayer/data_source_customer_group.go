@@ -0,0 +1,103 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceCustomerGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing customer group by id, name or reference. This is useful to scope markets " +
+			"to customer groups that are maintained outside of terraform, e.g. by a CRM sync.",
+		ReadContext: dataSourceCustomerGroupReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The customer group unique identifier",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name", "reference"},
+			},
+			"name": {
+				Description:  "The customer group name, which must match exactly one customer group",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name", "reference"},
+			},
+			"reference": {
+				Description: "The external identifier of the customer group, typically its code in the CRM, which " +
+					"must match exactly one customer group",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name", "reference"},
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the customer group",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCustomerGroupReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	var customerGroup *jsonApiResource
+	if id, ok := d.GetOk("id"); ok {
+		resource, err := getResource(ctx, c, "/customer_groups/"+id.(string), nil)
+		if err != nil {
+			return diagErr(err)
+		}
+		customerGroup = resource
+	} else {
+		query := url.Values{}
+		if name, ok := d.GetOk("name"); ok {
+			query.Set("filter[q][name_eq]", name.(string))
+		} else {
+			query.Set("filter[q][reference_eq]", d.Get("reference").(string))
+		}
+
+		resource, err := findResource(ctx, c, "/customer_groups", query)
+		if err != nil {
+			return diagErr(err)
+		}
+		customerGroup = resource
+	}
+
+	var attributes commercelayer.GETCustomerGroups200ResponseDataInnerAttributes
+	err := customerGroup.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected customer group %s: %s", customerGroup.Id, err)
+	}
+
+	d.SetId(customerGroup.Id)
+
+	values := map[string]interface{}{
+		"name":             attributes.GetName(),
+		"reference":        attributes.GetReference(),
+		"reference_origin": attributes.GetReferenceOrigin(),
+		"metadata":         stringMap(attributes.GetMetadata()),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceCustomerGroup_basic() {
+	resourceName := "data.commercelayer_customer_group.incentro_customer_group"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCustomerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccCustomerGroupCreate(resourceName),
+					testAccDataSourceCustomerGroup()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"commercelayer_customer_group.incentro_customer_group", "id"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceCustomerGroup() string {
+	return `
+		data "commercelayer_customer_group" "incentro_customer_group" {
+		  name = commercelayer_customer_group.incentro_customer_group.attributes[0].name
+		}
+	`
+}
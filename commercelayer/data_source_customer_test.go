@@ -0,0 +1,37 @@
+package commercelayer
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceCustomer_basic() {
+	resourceName := "data.commercelayer_customer.incentro_customer"
+
+	testAccPreCheck(s)
+	customerId := testAccCustomer(s, "data-source@incentro.com")
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceCustomer(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", customerId),
+					resource.TestCheckResourceAttr(resourceName, "status", "prospect"),
+					resource.TestCheckResourceAttr(resourceName, "has_password", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceCustomer() string {
+	return `
+		data "commercelayer_customer" "incentro_customer" {
+		  email = "data-source@incentro.com"
+		}
+	`
+}
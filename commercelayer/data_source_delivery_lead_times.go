@@ -0,0 +1,167 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceDeliveryLeadTimes() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "List the delivery lead times, optionally filtered by stock location or shipping method. This " +
+			"is useful to export delivery SLAs, e.g. to a status page.",
+		ReadContext: dataSourceDeliveryLeadTimesReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The identifier of the applied filters",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filter": queryFilterSchema(),
+			"stock_location_id": {
+				Description: "Only list the delivery lead times from this stock location",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"shipping_method_id": {
+				Description: "Only list the delivery lead times of this shipping method",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"delivery_lead_times": {
+				Description: "The matching delivery lead times, sorted by minimum hours",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The delivery lead time unique identifier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"stock_location_id": {
+							Description: "The associated stock location id",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"shipping_method_id": {
+							Description: "The associated shipping method id",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"min_hours": {
+							Description: "The delivery lead minimum time, in hours",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"max_hours": {
+							Description: "The delivery lead maximum time, in hours",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"min_days": {
+							Description: "The delivery lead minimum time, in days, rounded",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"max_days": {
+							Description: "The delivery lead maximum time, in days, rounded",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"reference": {
+							Description: "The external identifier of the delivery lead time",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference_origin": {
+							Description: "The identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"metadata": {
+							Description: "The key-value pairs attached to the delivery lead time",
+							Type:        schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for key, value := range queryPaginationSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourceDeliveryLeadTimesReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	filters := url.Values{}
+	if stockLocationId, ok := d.GetOk("stock_location_id"); ok {
+		filters.Set("filter[q][stock_location_id_eq]", stockLocationId.(string))
+	}
+	if shippingMethodId, ok := d.GetOk("shipping_method_id"); ok {
+		filters.Set("filter[q][shipping_method_id_eq]", shippingMethodId.(string))
+	}
+
+	if err := expandQueryFilters(d, filters); err != nil {
+		return diagErr(err)
+	}
+
+	query := url.Values{
+		"include": {"stock_location,shipping_method"},
+		"sort":    {"min_hours"},
+	}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	resources, truncated, err := listDataSourceResources(ctx, c, d, "/delivery_lead_times", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	deliveryLeadTimes := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		var attributes commercelayer.GETDeliveryLeadTimes200ResponseDataInnerAttributes
+		err := resource.decodeAttributes(&attributes)
+		if err != nil {
+			return diag.Errorf("unexpected delivery lead time %s: %s", resource.Id, err)
+		}
+
+		deliveryLeadTimes = append(deliveryLeadTimes, map[string]interface{}{
+			"id":                 resource.Id,
+			"stock_location_id":  resource.relationship("stock_location").Id,
+			"shipping_method_id": resource.relationship("shipping_method").Id,
+			"min_hours":          int(attributes.GetMinHours()),
+			"max_hours":          int(attributes.GetMaxHours()),
+			"min_days":           int(attributes.GetMinDays()),
+			"max_days":           int(attributes.GetMaxDays()),
+			"reference":          attributes.GetReference(),
+			"reference_origin":   attributes.GetReferenceOrigin(),
+			"metadata":           stringMap(attributes.GetMetadata()),
+		})
+	}
+
+	d.SetId(queryId(filters))
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diagErr(err)
+	}
+
+	if err := d.Set("delivery_lead_times", deliveryLeadTimes); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceDeliveryLeadTimesRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourceDeliveryLeadTimes(), map[string]interface{}{
+		"stock_location_id": "wGvXQsRkPn",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/delivery_lead_times", r.URL.Path)
+		assert.Equal(t, "wGvXQsRkPn", r.URL.Query().Get("filter[q][stock_location_id_eq]"))
+		assert.Equal(t, "stock_location,shipping_method", r.URL.Query().Get("include"))
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "jDkzLtRqWm",
+				"type": "delivery_lead_times",
+				"attributes": {"min_hours": 24, "max_hours": 48, "min_days": 1, "max_days": 2},
+				"relationships": {
+					"stock_location": {"data": {"id": "wGvXQsRkPn", "type": "stock_locations"}},
+					"shipping_method": {"data": {"id": "pZxMvNaBcD", "type": "shipping_methods"}}
+				}
+			}],
+			"meta": {"record_count": 1, "page_count": 1}
+		}`))
+	})
+
+	diags := dataSourceDeliveryLeadTimesReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, d.Get("delivery_lead_times.#"))
+	assert.Equal(t, "pZxMvNaBcD", d.Get("delivery_lead_times.0.shipping_method_id"))
+	assert.Equal(t, 48, d.Get("delivery_lead_times.0.max_hours"))
+	assert.Equal(t, 2, d.Get("delivery_lead_times.0.max_days"))
+}
@@ -0,0 +1,177 @@
+package commercelayer
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceExport() *schema.Resource {
+	lookupKeys := []string{"id", "reference", "resource_type"}
+
+	return &schema.Resource{
+		Description: "Resolve a completed export by id, or the most recently completed export matching a " +
+			"reference and/or resource type, along with the URL of its file. This is useful to hand off " +
+			"exported data to BI jobs through terraform outputs.",
+		ReadContext: dataSourceExportReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:   "The export unique identifier",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"reference", "resource_type"},
+				AtLeastOneOf:  lookupKeys,
+			},
+			"reference": {
+				Description:  "The external identifier of the export",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: lookupKeys,
+			},
+			"resource_type": {
+				Description:  "The type of resource that was exported, e.g. skus or prices",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: lookupKeys,
+			},
+			"format": {
+				Description: "The format of the export, one of 'csv' or 'json'",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"includes": {
+				Description: "The related resources that were included in the export",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"filters": {
+				Description: "The JSON encoded filters used to select the exported records",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"dry_data": {
+				Description: "Indicates if redundant attributes were skipped",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"started_at": {
+				Description: "Time at which the export was started",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"completed_at": {
+				Description: "Time at which the export was completed",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"records_count": {
+				Description: "The number of records that have been exported",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"attachment_url": {
+				Description: "The URL of the exported file",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the export",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceExportReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	var export *jsonApiResource
+	if id, ok := d.GetOk("id"); ok {
+		resource, err := getResource(ctx, c, "/exports/"+id.(string), nil)
+		if err != nil {
+			return diagErr(err)
+		}
+		export = resource
+	} else {
+		query := url.Values{
+			"filter[q][status_eq]": {"completed"},
+			"sort":                 {"-completed_at"},
+		}
+		if reference, ok := d.GetOk("reference"); ok {
+			query.Set("filter[q][reference_eq]", reference.(string))
+		}
+		if resourceType, ok := d.GetOk("resource_type"); ok {
+			query.Set("filter[q][resource_type_eq]", resourceType.(string))
+		}
+
+		resources, err := firstResources(ctx, c, "/exports", query, 1)
+		if err != nil {
+			return diagErr(err)
+		}
+		if len(resources) == 0 {
+			return diag.Errorf("no completed export matches")
+		}
+		export = &resources[0]
+	}
+
+	var attributes commercelayer.GETExports200ResponseDataInnerAttributes
+	err := export.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected export %s: %s", export.Id, err)
+	}
+
+	if attributes.GetStatus() != "completed" {
+		return diag.Errorf("export %s is %s, not completed", export.Id, attributes.GetStatus())
+	}
+
+	var filters []byte
+	if len(attributes.GetFilters()) > 0 {
+		filters, err = json.Marshal(attributes.GetFilters())
+		if err != nil {
+			return diagErr(err)
+		}
+	}
+
+	d.SetId(export.Id)
+
+	values := map[string]interface{}{
+		"resource_type":    attributes.GetResourceType(),
+		"format":           attributes.GetFormat(),
+		"includes":         attributes.GetIncludes(),
+		"filters":          string(filters),
+		"dry_data":         attributes.GetDryData(),
+		"started_at":       attributes.GetStartedAt(),
+		"completed_at":     attributes.GetCompletedAt(),
+		"records_count":    int(attributes.GetRecordsCount()),
+		"attachment_url":   attributes.GetAttachmentUrl(),
+		"reference":        attributes.GetReference(),
+		"reference_origin": attributes.GetReferenceOrigin(),
+		"metadata":         stringMap(attributes.GetMetadata()),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
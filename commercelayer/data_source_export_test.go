@@ -0,0 +1,42 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceExport_basic() {
+	resourceName := "data.commercelayer_export.incentro_export"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckExportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccExportCreate(resourceName),
+					testAccDataSourceExport()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"commercelayer_export.incentro_export", "id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "addresses"),
+					resource.TestCheckResourceAttrPair(resourceName, "attachment_url",
+						"commercelayer_export.incentro_export", "attachment_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceExport() string {
+	return `
+		data "commercelayer_export" "incentro_export" {
+		  id = commercelayer_export.incentro_export.id
+		}
+	`
+}
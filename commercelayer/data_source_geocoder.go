@@ -0,0 +1,103 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceGeocoder() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing geocoder of any kind (google or bing) by id or name. The geocoder type is " +
+			"returned along with its id, so the geocoder can be attached to markets and addresses without knowing " +
+			"its kind upfront.",
+		ReadContext: dataSourceGeocoderReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The geocoder unique identifier",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The geocoder name, which must match exactly one geocoder",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"type": {
+				Description: "The geocoder type, either google_geocoders or bing_geocoders",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference": {
+				Description: "The external identifier of the geocoder",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the geocoder",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGeocoderReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	var geocoder *jsonApiResource
+	if id, ok := d.GetOk("id"); ok {
+		resource, err := getResource(ctx, c, "/geocoders/"+id.(string), nil)
+		if err != nil {
+			return diagErr(err)
+		}
+		geocoder = resource
+	} else {
+		resource, err := findResource(ctx, c, "/geocoders", url.Values{
+			"filter[q][name_eq]": {d.Get("name").(string)},
+		})
+		if err != nil {
+			return diagErr(err)
+		}
+		geocoder = resource
+	}
+
+	// the attributes common to all the geocoders, the SDK uses the bing ones for the polymorphic endpoint
+	var attributes commercelayer.GETBingGeocoders200ResponseDataInnerAttributes
+	err := geocoder.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected geocoder %s: %s", geocoder.Id, err)
+	}
+
+	d.SetId(geocoder.Id)
+
+	values := map[string]interface{}{
+		"name":             attributes.GetName(),
+		"type":             geocoder.Type,
+		"reference":        attributes.GetReference(),
+		"reference_origin": attributes.GetReferenceOrigin(),
+		"metadata":         stringMap(attributes.GetMetadata()),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
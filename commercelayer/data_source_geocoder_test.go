@@ -0,0 +1,41 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceGeocoder_basic() {
+	resourceName := "data.commercelayer_geocoder.incentro_geocoder"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckGoogleGeocoderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccGoogleGeocoderCreate(resourceName),
+					testAccDataSourceGeocoder()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"commercelayer_google_geocoder.incentro_google_geocoder", "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", googleGeocodersType),
+					resource.TestCheckResourceAttr(resourceName, "metadata.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGeocoder() string {
+	return `
+		data "commercelayer_geocoder" "incentro_geocoder" {
+		  name = commercelayer_google_geocoder.incentro_google_geocoder.attributes[0].name
+		}
+	`
+}
@@ -0,0 +1,221 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+// giftCardAttributes fixes the type of balance_max_cents, which the API returns as an integer while the SDK expects
+// a string
+type giftCardAttributes struct {
+	commercelayer.GETGiftCards200ResponseDataInnerAttributes
+	BalanceMaxCents *int32 `json:"balance_max_cents,omitempty"`
+}
+
+func dataSourceGiftCards() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "List the gift cards, optionally filtered by market, status and recipient email. This is " +
+			"useful to expose gift card balances to finance reconciliation jobs through terraform outputs.",
+		ReadContext: dataSourceGiftCardsReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The identifier of the applied filters",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filter": queryFilterSchema(),
+			"market_id": {
+				Description: "Only list the gift cards of this market",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"status": {
+				Description: "Only list the gift cards with this status, one of 'draft', 'inactive', 'active' or " +
+					"'redeemed'",
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice([]string{"draft", "inactive", "active", "redeemed"}, false)),
+			},
+			"recipient_email": {
+				Description: "Only list the gift cards sent to this email address",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"gift_cards": {
+				Description: "The matching gift cards, sorted by creation time",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The gift card unique identifier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"code": {
+							Description: "The gift card code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The gift card status, one of 'draft', 'inactive', 'active' or 'redeemed'",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"currency_code": {
+							Description: "The international 3-letter currency code as defined by the ISO 4217 standard",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"initial_balance_cents": {
+							Description: "The gift card initial balance, in cents",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"balance_cents": {
+							Description: "The gift card balance, in cents",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"balance_max_cents": {
+							Description: "The maximum balance of a rechargeable gift card, in cents",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"single_use": {
+							Description: "Indicates if the gift card can be used only once",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"rechargeable": {
+							Description: "Indicates if the gift card can be recharged",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"expires_at": {
+							Description: "Time at which the gift card expires, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"recipient_email": {
+							Description: "The email address of the gift card recipient, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"market_id": {
+							Description: "The associated market id, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference": {
+							Description: "The external identifier of the gift card",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference_origin": {
+							Description: "The identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"metadata": {
+							Description: "The key-value pairs attached to the gift card",
+							Type:        schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for key, value := range queryPaginationSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourceGiftCardsReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	filters := url.Values{}
+	if marketId, ok := d.GetOk("market_id"); ok {
+		filters.Set("filter[q][market_id_eq]", marketId.(string))
+	}
+	if status, ok := d.GetOk("status"); ok {
+		filters.Set("filter[q][status_eq]", status.(string))
+	}
+	if recipientEmail, ok := d.GetOk("recipient_email"); ok {
+		filters.Set("filter[q][recipient_email_eq]", recipientEmail.(string))
+	}
+
+	if err := expandQueryFilters(d, filters); err != nil {
+		return diagErr(err)
+	}
+
+	query := url.Values{
+		"include": {"market"},
+		"sort":    {"created_at"},
+	}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	resources, truncated, err := listDataSourceResources(ctx, c, d, "/gift_cards", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	giftCards := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		var attributes giftCardAttributes
+		err := resource.decodeAttributes(&attributes)
+		if err != nil {
+			return diag.Errorf("unexpected gift card %s: %s", resource.Id, err)
+		}
+
+		var balanceMaxCents int
+		if attributes.BalanceMaxCents != nil {
+			balanceMaxCents = int(*attributes.BalanceMaxCents)
+		}
+
+		giftCards = append(giftCards, map[string]interface{}{
+			"id":                    resource.Id,
+			"code":                  attributes.GetCode(),
+			"status":                attributes.GetStatus(),
+			"currency_code":         attributes.GetCurrencyCode(),
+			"initial_balance_cents": int(attributes.GetInitialBalanceCents()),
+			"balance_cents":         int(attributes.GetBalanceCents()),
+			"balance_max_cents":     balanceMaxCents,
+			"single_use":            attributes.GetSingleUse(),
+			"rechargeable":          attributes.GetRechargeable(),
+			"expires_at":            attributes.GetExpiresAt(),
+			"recipient_email":       attributes.GetRecipientEmail(),
+			"market_id":             resource.relationship("market").Id,
+			"reference":             attributes.GetReference(),
+			"reference_origin":      attributes.GetReferenceOrigin(),
+			"metadata":              stringMap(attributes.GetMetadata()),
+		})
+	}
+
+	d.SetId(queryId(filters))
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diagErr(err)
+	}
+
+	if err := d.Set("gift_cards", giftCards); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
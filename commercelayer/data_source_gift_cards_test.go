@@ -0,0 +1,46 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceGiftCardsRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourceGiftCards(), map[string]interface{}{
+		"market_id": "market-nl",
+		"status":    "active",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/gift_cards", r.URL.Path)
+		assert.Equal(t, "market-nl", r.URL.Query().Get("filter[q][market_id_eq]"))
+		assert.Equal(t, "active", r.URL.Query().Get("filter[q][status_eq]"))
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "gift-card-1",
+				"type": "gift_cards",
+				"attributes": {
+					"code": "GC-1",
+					"status": "active",
+					"currency_code": "EUR",
+					"initial_balance_cents": 5000,
+					"balance_cents": 1250,
+					"balance_max_cents": 10000,
+					"rechargeable": true,
+					"recipient_email": "jane@example.com"
+				},
+				"relationships": {"market": {"data": {"id": "market-nl", "type": "markets"}}}
+			}],
+			"meta": {"record_count": 1, "page_count": 1}
+		}`))
+	})
+
+	diags := dataSourceGiftCardsReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, d.Get("gift_cards.#"))
+	assert.Equal(t, 1250, d.Get("gift_cards.0.balance_cents"))
+	assert.Equal(t, 10000, d.Get("gift_cards.0.balance_max_cents"))
+	assert.Equal(t, "market-nl", d.Get("gift_cards.0.market_id"))
+}
@@ -0,0 +1,184 @@
+package commercelayer
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceImport() *schema.Resource {
+	return &schema.Resource{
+		Description: "Read the status of an existing import by id or reference. This is useful to gate applies on " +
+			"the outcome of imports that are submitted outside of terraform, e.g. by a pipeline.",
+		ReadContext: dataSourceImportReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The import unique identifier",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "reference"},
+			},
+			"reference": {
+				Description:  "The external identifier of the import, which must match exactly one import",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "reference"},
+			},
+			"resource_type": {
+				Description: "The type of resource being imported, e.g. skus or prices",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"parent_resource_id": {
+				Description: "The ID of the parent resource associated with the imported data, if any",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The import job status, one of 'pending', 'in_progress', 'interrupted' or 'completed'",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"started_at": {
+				Description: "Time at which the import was started",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"completed_at": {
+				Description: "Time at which the import was completed, if it has",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"interrupted_at": {
+				Description: "Time at which the import was interrupted, if it has",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"inputs_size": {
+				Description: "The number of inputs of the import",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"processed_count": {
+				Description: "The number of resources that have been processed",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"destroyed_count": {
+				Description: "The number of resources that have been destroyed by the cleanup of records",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"warnings_count": {
+				Description: "The number of warnings raised during the import",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"errors_count": {
+				Description: "The number of errors raised during the import",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"warnings_log": {
+				Description: "The JSON encoded warnings, if any, indexed by input",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"errors_log": {
+				Description: "The JSON encoded errors, if any, indexed by input",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"cleanup_records": {
+				Description: "Indicates if the import cleans up the records that are not included in the inputs",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the import",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceImportReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	var importJob *jsonApiResource
+	if id, ok := d.GetOk("id"); ok {
+		resource, err := getResource(ctx, c, "/imports/"+id.(string), nil)
+		if err != nil {
+			return diagErr(err)
+		}
+		importJob = resource
+	} else {
+		resource, err := findResource(ctx, c, "/imports", url.Values{
+			"filter[q][reference_eq]": {d.Get("reference").(string)},
+		})
+		if err != nil {
+			return diagErr(err)
+		}
+		importJob = resource
+	}
+
+	var attributes commercelayer.GETImports200ResponseDataInnerAttributes
+	err := importJob.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected import %s: %s", importJob.Id, err)
+	}
+
+	warningsLog, err := json.Marshal(attributes.GetWarningsLog())
+	if err != nil {
+		return diagErr(err)
+	}
+
+	errorsLog, err := json.Marshal(attributes.GetErrorsLog())
+	if err != nil {
+		return diagErr(err)
+	}
+
+	d.SetId(importJob.Id)
+
+	values := map[string]interface{}{
+		"resource_type":      attributes.GetResourceType(),
+		"parent_resource_id": attributes.GetParentResourceId(),
+		"status":             attributes.GetStatus(),
+		"started_at":         attributes.GetStartedAt(),
+		"completed_at":       attributes.GetCompletedAt(),
+		"interrupted_at":     attributes.GetInterruptedAt(),
+		"inputs_size":        int(attributes.GetInputsSize()),
+		"processed_count":    int(attributes.GetProcessedCount()),
+		"destroyed_count":    int(attributes.GetDestroyedCount()),
+		"warnings_count":     int(attributes.GetWarningsCount()),
+		"errors_count":       int(attributes.GetErrorsCount()),
+		"warnings_log":       string(warningsLog),
+		"errors_log":         string(errorsLog),
+		"cleanup_records":    attributes.GetCleanupRecords(),
+		"reference":          attributes.GetReference(),
+		"reference_origin":   attributes.GetReferenceOrigin(),
+		"metadata":           stringMap(attributes.GetMetadata()),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
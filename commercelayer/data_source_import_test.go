@@ -0,0 +1,43 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceImport_basic() {
+	resourceName := "data.commercelayer_import.incentro_import"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccImportCreate(resourceName),
+					testAccDataSourceImport()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"commercelayer_import.incentro_import", "id"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "addresses"),
+					resource.TestCheckResourceAttr(resourceName, "status", "completed"),
+					resource.TestCheckResourceAttr(resourceName, "processed_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "errors_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceImport() string {
+	return `
+		data "commercelayer_import" "incentro_import" {
+		  id = commercelayer_import.incentro_import.id
+		}
+	`
+}
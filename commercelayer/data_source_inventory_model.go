@@ -0,0 +1,108 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceInventoryModel() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing inventory model by id or name. This is useful to attach markets managed " +
+			"in separate workspaces to a shared inventory model.",
+		ReadContext: dataSourceInventoryModelReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The inventory model unique identifier",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The inventory model name, which must match exactly one inventory model",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"strategy": {
+				Description: "The inventory model's shipping strategy, one of 'no_split', 'split_shipments', " +
+					"'split_by_line_items', 'ship_from_primary' or 'ship_from_first_available_or_primary'",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stock_locations_cutoff": {
+				Description: "The maximum number of stock locations used for inventory computation",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"reference": {
+				Description: "The external identifier of the inventory model",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the inventory model",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceInventoryModelReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	var inventoryModel *jsonApiResource
+	if id, ok := d.GetOk("id"); ok {
+		resource, err := getResource(ctx, c, "/inventory_models/"+id.(string), nil)
+		if err != nil {
+			return diagErr(err)
+		}
+		inventoryModel = resource
+	} else {
+		resource, err := findResource(ctx, c, "/inventory_models", url.Values{
+			"filter[q][name_eq]": {d.Get("name").(string)},
+		})
+		if err != nil {
+			return diagErr(err)
+		}
+		inventoryModel = resource
+	}
+
+	var attributes commercelayer.GETInventoryModels200ResponseDataInnerAttributes
+	err := inventoryModel.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected inventory model %s: %s", inventoryModel.Id, err)
+	}
+
+	d.SetId(inventoryModel.Id)
+
+	values := map[string]interface{}{
+		"name":                   attributes.GetName(),
+		"strategy":               attributes.GetStrategy(),
+		"stock_locations_cutoff": int(attributes.GetStockLocationsCutoff()),
+		"reference":              attributes.GetReference(),
+		"reference_origin":       attributes.GetReferenceOrigin(),
+		"metadata":               stringMap(attributes.GetMetadata()),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
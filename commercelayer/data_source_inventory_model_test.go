@@ -0,0 +1,41 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceInventoryModel_basic() {
+	resourceName := "data.commercelayer_inventory_model.incentro_inventory_model"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckInventoryModelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccInventoryModelCreate(resourceName),
+					testAccDataSourceInventoryModel()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"commercelayer_inventory_model.incentro_inventory_model", "id"),
+					resource.TestCheckResourceAttr(resourceName, "strategy", "no_split"),
+					resource.TestCheckResourceAttr(resourceName, "stock_locations_cutoff", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceInventoryModel() string {
+	return `
+		data "commercelayer_inventory_model" "incentro_inventory_model" {
+		  name = commercelayer_inventory_model.incentro_inventory_model.attributes[0].name
+		}
+	`
+}
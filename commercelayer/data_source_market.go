@@ -0,0 +1,208 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+// marketIncludes are the relationships included when reading markets, so that their ids are returned
+const marketIncludes = "merchant,price_list,inventory_model,tax_calculator,customer_group"
+
+func dataSourceMarket() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "Look up an existing market by id, number or name. This is useful to reference markets that " +
+			"are managed in another terraform state, or outside of terraform.",
+		ReadContext: dataSourceMarketReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The market unique identifier",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "number", "name"},
+			},
+			"number": {
+				Description:  "The market number",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "number", "name"},
+			},
+			"name": {
+				Description:  "The market name, which must match exactly one market",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "number", "name"},
+			},
+		},
+	}
+
+	for key, value := range marketDataSourceSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourceMarketReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	query := url.Values{"include": {marketIncludes}}
+
+	var market *jsonApiResource
+	if id, ok := d.GetOk("id"); ok {
+		resource, err := getResource(ctx, c, "/markets/"+id.(string), query)
+		if err != nil {
+			return diagErr(err)
+		}
+		market = resource
+	} else {
+		if number, ok := d.GetOk("number"); ok {
+			query.Set("filter[q][number_eq]", strconv.Itoa(number.(int)))
+		} else {
+			query.Set("filter[q][name_eq]", d.Get("name").(string))
+		}
+
+		resource, err := findResource(ctx, c, "/markets", query)
+		if err != nil {
+			return diagErr(err)
+		}
+		market = resource
+	}
+
+	values, err := flattenMarket(*market)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	d.SetId(market.Id)
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
+
+// flattenMarket returns the data source values of a market read with the marketIncludes
+func flattenMarket(market jsonApiResource) (map[string]interface{}, error) {
+	var attributes commercelayer.GETMarkets200ResponseDataInnerAttributes
+	err := market.decodeAttributes(&attributes)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected market %s: %w", market.Id, err)
+	}
+
+	return map[string]interface{}{
+		"number":                        int(attributes.GetNumber()),
+		"name":                          attributes.GetName(),
+		"facebook_pixel_id":             attributes.GetFacebookPixelId(),
+		"checkout_url":                  attributes.GetCheckoutUrl(),
+		"external_prices_url":           attributes.GetExternalPricesUrl(),
+		"external_order_validation_url": attributes.GetExternalOrderValidationUrl(),
+		"shared_secret":                 attributes.GetSharedSecret(),
+		"private":                       attributes.GetPrivate(),
+		"disabled_at":                   attributes.GetDisabledAt(),
+		"reference":                     attributes.GetReference(),
+		"reference_origin":              attributes.GetReferenceOrigin(),
+		"metadata":                      stringMap(attributes.GetMetadata()),
+		"merchant_id":                   market.relationship("merchant").Id,
+		"price_list_id":                 market.relationship("price_list").Id,
+		"inventory_model_id":            market.relationship("inventory_model").Id,
+		"tax_calculator_id":             market.relationship("tax_calculator").Id,
+		"customer_group_id":             market.relationship("customer_group").Id,
+	}, nil
+}
+
+// marketDataSourceSchema returns the computed attributes of a market, shared by the market and markets data sources
+func marketDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"facebook_pixel_id": {
+			Description: "The Facebook Pixel ID",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"checkout_url": {
+			Description: "The checkout URL for this market",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"external_prices_url": {
+			Description: "The URL used to fetch prices from an external source",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"external_order_validation_url": {
+			Description: "The URL used to validate orders by an external source",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"shared_secret": {
+			Description: "The shared secret used to sign the external requests payload",
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+		},
+		"private": {
+			Description: "Indicates if the market is private, i.e. only accessible with a customer scoped token",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"disabled_at": {
+			Description: "Time at which the market was disabled, empty while the market is enabled",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"reference": {
+			Description: "The external identifier of the market",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"reference_origin": {
+			Description: "The identifier of the third party system that defines the reference code",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"metadata": {
+			Description: "The key-value pairs attached to the market",
+			Type:        schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Computed: true,
+		},
+		"merchant_id": {
+			Description: "The associated merchant id",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"price_list_id": {
+			Description: "The associated price list id",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"inventory_model_id": {
+			Description: "The associated inventory model id",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"tax_calculator_id": {
+			Description: "The associated tax calculator id, if any",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"customer_group_id": {
+			Description: "The associated customer group id, if any",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
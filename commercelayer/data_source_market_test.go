@@ -0,0 +1,54 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceMarket_basic() {
+	resourceName := "data.commercelayer_market.incentro_market"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMarketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccAddressCreate(resourceName),
+					testAccInventoryModelCreate(resourceName),
+					testAccMerchantCreate(resourceName),
+					testAccPriceListCreate(resourceName),
+					testAccMarketCreate(resourceName),
+					testAccDataSourceMarket()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Incentro Market"),
+					resource.TestCheckResourceAttrPair(resourceName, "number",
+						"data.commercelayer_market.incentro_market_by_number", "number"),
+					resource.TestCheckResourceAttrPair(resourceName, "price_list_id",
+						"commercelayer_price_list.incentro_price_list", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "merchant_id",
+						"commercelayer_merchant.incentro_merchant", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "inventory_model_id",
+						"commercelayer_inventory_model.incentro_inventory_model", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMarket() string {
+	return `
+		data "commercelayer_market" "incentro_market" {
+		  id = commercelayer_market.incentro_market.id
+		}
+
+		data "commercelayer_market" "incentro_market_by_number" {
+		  number = data.commercelayer_market.incentro_market.number
+		}
+	`
+}
@@ -0,0 +1,138 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceMarkets() *schema.Resource {
+	market := marketDataSourceSchema()
+	market["id"] = &schema.Schema{
+		Description: "The market unique identifier",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	market["number"] = &schema.Schema{
+		Description: "The market number",
+		Type:        schema.TypeInt,
+		Computed:    true,
+	}
+	market["name"] = &schema.Schema{
+		Description: "The market name",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+
+	dataSource := &schema.Resource{
+		Description: "List the markets of the organization, optionally filtered. This can be used with for_each to " +
+			"manage a resource per market, e.g. one webhook or payment method per market.",
+		ReadContext: dataSourceMarketsReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The identifier of the applied filters",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filter": queryFilterSchema(),
+			"name": {
+				Description: "Only list the markets with this exact name",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"name_contains": {
+				Description: "Only list the markets whose name contains this string",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"reference": {
+				Description: "Only list the markets with this reference",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"disabled": {
+				Description: "When set, only list the disabled markets if true, or the enabled ones if false",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"markets": {
+				Description: "The matching markets, sorted by number",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: market,
+				},
+			},
+		},
+	}
+
+	for key, value := range queryPaginationSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourceMarketsReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	filters := url.Values{}
+	if name, ok := d.GetOk("name"); ok {
+		filters.Set("filter[q][name_eq]", name.(string))
+	}
+	if nameContains, ok := d.GetOk("name_contains"); ok {
+		filters.Set("filter[q][name_cont]", nameContains.(string))
+	}
+	if reference, ok := d.GetOk("reference"); ok {
+		filters.Set("filter[q][reference_eq]", reference.(string))
+	}
+	// disabled is read from the raw config, as false can't be told apart from an unset value otherwise
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() {
+		if disabled := rawConfig.GetAttr("disabled"); !disabled.IsNull() {
+			filters.Set("filter[q][disabled_at_null]", strconv.FormatBool(disabled.False()))
+		}
+	}
+
+	if err := expandQueryFilters(d, filters); err != nil {
+		return diagErr(err)
+	}
+
+	query := url.Values{
+		"include": {marketIncludes},
+		"sort":    {"number"},
+	}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	resources, truncated, err := listDataSourceResources(ctx, c, d, "/markets", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	markets := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		market, err := flattenMarket(resource)
+		if err != nil {
+			return diagErr(err)
+		}
+		market["id"] = resource.Id
+		markets = append(markets, market)
+	}
+
+	d.SetId(queryId(filters))
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diagErr(err)
+	}
+
+	if err := d.Set("markets", markets); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
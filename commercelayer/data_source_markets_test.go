@@ -0,0 +1,38 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceMarketsRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourceMarkets(), map[string]interface{}{
+		"name_contains": "Europe",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Europe", r.URL.Query().Get("filter[q][name_cont]"))
+		assert.Equal(t, "number", r.URL.Query().Get("sort"))
+		assert.Equal(t, marketIncludes, r.URL.Query().Get("include"))
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "xYZkjABcde",
+				"type": "markets",
+				"attributes": {"name": "Europe B2C", "number": 1, "metadata": {"foo": "bar", "tier": 1}},
+				"relationships": {"price_list": {"data": {"id": "vLrWQSwJeA", "type": "price_lists"}}}
+			}],
+			"meta": {"record_count": 1, "page_count": 1}
+		}`))
+	})
+
+	diags := dataSourceMarketsReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, 1, d.Get("markets.#"))
+	assert.Equal(t, "xYZkjABcde", d.Get("markets.0.id"))
+	assert.Equal(t, "Europe B2C", d.Get("markets.0.name"))
+	assert.Equal(t, "vLrWQSwJeA", d.Get("markets.0.price_list_id"))
+	assert.Equal(t, "1", d.Get("markets.0.metadata.tier"))
+}
@@ -0,0 +1,130 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceMerchant() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing merchant and its address. Most organizations have a single merchant, " +
+			"created during the onboarding, which is returned when neither the id nor the name is set. This is " +
+			"useful to reference the merchant from markets without managing it with terraform.",
+		ReadContext: dataSourceMerchantReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:   "The merchant unique identifier",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+			"name": {
+				Description: "The merchant name. When neither the id nor the name is set, the organization must " +
+					"have exactly one merchant.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"reference": {
+				Description: "The external identifier of the merchant",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the merchant",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"address_id": {
+				Description: "The associated address id",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"address": {
+				Description: "The merchant address",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: addressDataSourceSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMerchantReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	query := url.Values{"include": {"address"}}
+
+	var merchant *jsonApiResource
+	if id, ok := d.GetOk("id"); ok {
+		resource, err := getResource(ctx, c, "/merchants/"+id.(string), query)
+		if err != nil {
+			return diagErr(err)
+		}
+		merchant = resource
+	} else {
+		if name, ok := d.GetOk("name"); ok {
+			query.Set("filter[q][name_eq]", name.(string))
+		}
+
+		resource, err := findResource(ctx, c, "/merchants", query)
+		if err != nil {
+			return diagErr(err)
+		}
+		merchant = resource
+	}
+
+	var attributes commercelayer.GETMerchants200ResponseDataInnerAttributes
+	err := merchant.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected merchant %s: %s", merchant.Id, err)
+	}
+
+	var addresses []interface{}
+	addressId := merchant.relationship("address").Id
+	if addressId != "" {
+		address, err := getResource(ctx, c, "/addresses/"+addressId, nil)
+		if err != nil {
+			return diagErr(err)
+		}
+
+		values, err := flattenAddress(*address)
+		if err != nil {
+			return diagErr(err)
+		}
+		addresses = append(addresses, values)
+	}
+
+	d.SetId(merchant.Id)
+
+	values := map[string]interface{}{
+		"name":             attributes.GetName(),
+		"reference":        attributes.GetReference(),
+		"reference_origin": attributes.GetReferenceOrigin(),
+		"metadata":         stringMap(attributes.GetMetadata()),
+		"address_id":       addressId,
+		"address":          addresses,
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceMerchant_basic() {
+	resourceName := "data.commercelayer_merchant.incentro_merchant"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckMerchantDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccAddressCreate(resourceName),
+					testAccMerchantCreate(resourceName),
+					testAccDataSourceMerchant()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"commercelayer_merchant.incentro_merchant", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "address_id",
+						"commercelayer_address.incentro_address", "id"),
+					resource.TestCheckResourceAttr(resourceName, "address.0.company", "Incentro"),
+					resource.TestCheckResourceAttr(resourceName, "address.0.city", "Rotterdam"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMerchant() string {
+	return `
+		data "commercelayer_merchant" "incentro_merchant" {
+		  name = commercelayer_merchant.incentro_merchant.attributes[0].name
+		}
+	`
+}
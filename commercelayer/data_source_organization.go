@@ -0,0 +1,183 @@
+package commercelayer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceOrganization() *schema.Resource {
+	return &schema.Resource{
+		Description: "Information about the organization the provider credentials belong to, like its slug, its " +
+			"mode and its limits. This can be used by modules to branch on the mode, or to build URLs containing " +
+			"the organization slug, e.g. for webhooks.",
+		ReadContext: dataSourceOrganizationReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The organization unique identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The organization's internal name",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"slug": {
+				Description: "The organization's slug name, as used in its base endpoint",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"mode": {
+				Description: "The mode the provider credentials have been issued for, either 'test' or 'live'",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"domain": {
+				Description: "The organization's domain",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"support_phone": {
+				Description: "The organization's support phone",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"support_email": {
+				Description: "The organization's support email",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"logo_url": {
+				Description: "The URL to the organization's logo",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"favicon_url": {
+				Description: "The URL to the organization's favicon",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"primary_color": {
+				Description: "The organization's primary color",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"contrast_color": {
+				Description: "The organization's contrast color",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"gtm_id": {
+				Description: "The organization's Google Tag Manager ID",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"gtm_id_test": {
+				Description: "The organization's Google Tag Manager ID for test",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"discount_disabled": {
+				Description: "Indicates if the discount is disabled on the organization's hosted applications",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"account_disabled": {
+				Description: "Indicates if the customer account is disabled on the organization's hosted applications",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"acceptance_disabled": {
+				Description: "Indicates if the acceptance of the terms is disabled on the organization's hosted " +
+					"applications",
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"max_concurrent_promotions": {
+				Description: "The maximum number of active concurrent promotions allowed for the organization",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"max_concurrent_imports": {
+				Description: "The maximum number of concurrent imports allowed for the organization",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"reference": {
+				Description: "The external identifier of the organization",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the organization",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceOrganizationReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	claims, err := clientAccessTokenClaims(c)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	mode, err := claims.mode()
+	if err != nil {
+		return diagErr(err)
+	}
+
+	resp, _, err := c.OrganizationApi.GETOrganizationOrganizationId(ctx).Execute()
+	if err != nil {
+		return diagErr(err)
+	}
+
+	organization := resp.GetData()
+	attributes := organization.GetAttributes()
+
+	d.SetId(organization.GetId())
+
+	values := map[string]interface{}{
+		"name":                      attributes.GetName(),
+		"slug":                      attributes.GetSlug(),
+		"mode":                      mode,
+		"domain":                    attributes.GetDomain(),
+		"support_phone":             attributes.GetSupportPhone(),
+		"support_email":             attributes.GetSupportEmail(),
+		"logo_url":                  attributes.GetLogoUrl(),
+		"favicon_url":               attributes.GetFaviconUrl(),
+		"primary_color":             attributes.GetPrimaryColor(),
+		"contrast_color":            attributes.GetContrastColor(),
+		"gtm_id":                    attributes.GetGtmId(),
+		"gtm_id_test":               attributes.GetGtmIdTest(),
+		"discount_disabled":         attributes.GetDiscountDisabled(),
+		"account_disabled":          attributes.GetAccountDisabled(),
+		"acceptance_disabled":       attributes.GetAcceptanceDisabled(),
+		"max_concurrent_promotions": int(attributes.GetMaxConcurrentPromotions()),
+		"max_concurrent_imports":    int(attributes.GetMaxConcurrentImports()),
+		"reference":                 attributes.GetReference(),
+		"reference_origin":          attributes.GetReferenceOrigin(),
+		"metadata":                  stringMap(attributes.GetMetadata()),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceOrganizationRead(t *testing.T) {
+	c := testTokenClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/organization", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"id": "WXlEOFrjnr",
+				"type": "organizations",
+				"attributes": {"name": "Incentro", "slug": "incentro", "max_concurrent_imports": 10}
+			}
+		}`))
+	}, `{"organization":{"id":"WXlEOFrjnr","slug":"incentro"},"test":true}`)
+	d := schema.TestResourceDataRaw(t, dataSourceOrganization().Schema, map[string]interface{}{})
+
+	diags := dataSourceOrganizationReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "WXlEOFrjnr", d.Id())
+	assert.Equal(t, "incentro", d.Get("slug"))
+	assert.Equal(t, "test", d.Get("mode"))
+	assert.Equal(t, 10, d.Get("max_concurrent_imports"))
+}
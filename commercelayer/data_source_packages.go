@@ -0,0 +1,165 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourcePackages() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "List the packages, i.e. the packaging definitions used to ship parcels, optionally filtered " +
+			"by stock location. This is useful to reference existing packages from parcel automation.",
+		ReadContext: dataSourcePackagesReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The identifier of the applied filters",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filter": queryFilterSchema(),
+			"stock_location_id": {
+				Description: "Only list the packages of this stock location",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"packages": {
+				Description: "The matching packages, sorted by code",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The package unique identifier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"code": {
+							Description: "The package identifying code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The package internal name",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"length": {
+							Description: "The package length",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"width": {
+							Description: "The package width",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"height": {
+							Description: "The package height",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"unit_of_length": {
+							Description: "The unit of length, one of 'cm' or 'in'",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"stock_location_id": {
+							Description: "The associated stock location id",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference": {
+							Description: "The external identifier of the package",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference_origin": {
+							Description: "The identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"metadata": {
+							Description: "The key-value pairs attached to the package",
+							Type:        schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for key, value := range queryPaginationSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourcePackagesReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	filters := url.Values{}
+	if stockLocationId, ok := d.GetOk("stock_location_id"); ok {
+		filters.Set("filter[q][stock_location_id_eq]", stockLocationId.(string))
+	}
+
+	if err := expandQueryFilters(d, filters); err != nil {
+		return diagErr(err)
+	}
+
+	query := url.Values{
+		"include": {"stock_location"},
+		"sort":    {"code"},
+	}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	resources, truncated, err := listDataSourceResources(ctx, c, d, "/packages", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	packages := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		var attributes commercelayer.GETPackages200ResponseDataInnerAttributes
+		err := resource.decodeAttributes(&attributes)
+		if err != nil {
+			return diag.Errorf("unexpected package %s: %s", resource.Id, err)
+		}
+
+		packages = append(packages, map[string]interface{}{
+			"id":                resource.Id,
+			"code":              attributes.GetCode(),
+			"name":              attributes.GetName(),
+			"length":            float64(attributes.GetLength()),
+			"width":             float64(attributes.GetWidth()),
+			"height":            float64(attributes.GetHeight()),
+			"unit_of_length":    attributes.GetUnitOfLength(),
+			"stock_location_id": resource.relationship("stock_location").Id,
+			"reference":         attributes.GetReference(),
+			"reference_origin":  attributes.GetReferenceOrigin(),
+			"metadata":          stringMap(attributes.GetMetadata()),
+		})
+	}
+
+	d.SetId(queryId(filters))
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diagErr(err)
+	}
+
+	if err := d.Set("packages", packages); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
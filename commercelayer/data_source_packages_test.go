@@ -0,0 +1,36 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourcePackagesRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourcePackages(), map[string]interface{}{
+		"stock_location_id": "stock-location-ams",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/packages", r.URL.Path)
+		assert.Equal(t, "stock-location-ams", r.URL.Query().Get("filter[q][stock_location_id_eq]"))
+		assert.Equal(t, "code", r.URL.Query().Get("sort"))
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "package-small",
+				"type": "packages",
+				"attributes": {"code": "BOX-S", "name": "Small box", "length": 20, "width": 15, "height": 10.5, "unit_of_length": "cm"},
+				"relationships": {"stock_location": {"data": {"id": "stock-location-ams", "type": "stock_locations"}}}
+			}],
+			"meta": {"record_count": 1, "page_count": 1}
+		}`))
+	})
+
+	diags := dataSourcePackagesReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, d.Get("packages.#"))
+	assert.Equal(t, "BOX-S", d.Get("packages.0.code"))
+	assert.Equal(t, 10.5, d.Get("packages.0.height"))
+	assert.Equal(t, "stock-location-ams", d.Get("packages.0.stock_location_id"))
+}
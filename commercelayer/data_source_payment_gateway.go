@@ -0,0 +1,103 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourcePaymentGateway() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing payment gateway of any kind (adyen, braintree, checkout.com, external, " +
+			"klarna, manual, paypal or stripe) by id or name. The gateway type is returned along with its id, so " +
+			"the gateway can be referenced without knowing its kind upfront.",
+		ReadContext: dataSourcePaymentGatewayReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The payment gateway unique identifier",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The payment gateway name, which must match exactly one payment gateway",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"type": {
+				Description: "The payment gateway type, e.g. adyen_gateways or stripe_gateways",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference": {
+				Description: "The external identifier of the payment gateway",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the payment gateway",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePaymentGatewayReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	var paymentGateway *jsonApiResource
+	if id, ok := d.GetOk("id"); ok {
+		resource, err := getResource(ctx, c, "/payment_gateways/"+id.(string), nil)
+		if err != nil {
+			return diagErr(err)
+		}
+		paymentGateway = resource
+	} else {
+		resource, err := findResource(ctx, c, "/payment_gateways", url.Values{
+			"filter[q][name_eq]": {d.Get("name").(string)},
+		})
+		if err != nil {
+			return diagErr(err)
+		}
+		paymentGateway = resource
+	}
+
+	// the attributes common to all the payment gateways, the SDK uses the klarna ones for the polymorphic endpoint
+	var attributes commercelayer.GETKlarnaGateways200ResponseDataInnerAttributes
+	err := paymentGateway.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected payment gateway %s: %s", paymentGateway.Id, err)
+	}
+
+	d.SetId(paymentGateway.Id)
+
+	values := map[string]interface{}{
+		"name":             attributes.GetName(),
+		"type":             paymentGateway.Type,
+		"reference":        attributes.GetReference(),
+		"reference_origin": attributes.GetReferenceOrigin(),
+		"metadata":         stringMap(attributes.GetMetadata()),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourcePaymentGateway_basic() {
+	resourceName := "data.commercelayer_payment_gateway.incentro_payment_gateway"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckAdyenGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccAdyenGatewayCreate(resourceName),
+					testAccDataSourcePaymentGateway()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"commercelayer_adyen_gateway.incentro_adyen_gateway", "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", adyenGatewaysType),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePaymentGateway() string {
+	return `
+		data "commercelayer_payment_gateway" "incentro_payment_gateway" {
+		  name = commercelayer_adyen_gateway.incentro_adyen_gateway.attributes[0].name
+		}
+	`
+}
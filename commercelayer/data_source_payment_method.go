@@ -0,0 +1,139 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourcePaymentMethod() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing payment method by payment source type, optionally scoped to a market and " +
+			"a currency. This is useful to stage payment gateway swaps against payment methods that are managed " +
+			"by another configuration.",
+		ReadContext: dataSourcePaymentMethodReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The payment method unique identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"payment_source_type": {
+				Description: "The payment source type, can be one of: AdyenPayment, BraintreePayment, " +
+					"CheckoutComPayment, CreditCard, ExternalPayment, KlarnaPayment, PaypalPayment, " +
+					"StripePayment or WireTransfer",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: paymentSourceValidation,
+			},
+			"market_id": {
+				Description: "The market of the payment method",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"currency_code": {
+				Description: "The international 3-letter currency code as defined by the ISO 4217 standard. Exactly " +
+					"one payment method must match the payment source type and, when set, the market and currency.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"moto": {
+				Description: "Indicates if the payments are marked as MOTO",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"disabled_at": {
+				Description: "Time at which the payment method was disabled, empty while it is enabled",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"price_amount_cents": {
+				Description: "The payment method's price, in cents",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"reference": {
+				Description: "The external identifier of the payment method",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the payment method",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"payment_gateway_id": {
+				Description: "The associated payment gateway id",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"payment_gateway_type": {
+				Description: "The associated payment gateway type, e.g. adyen_gateways",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourcePaymentMethodReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	query := url.Values{
+		"include":                           {"market,payment_gateway"},
+		"filter[q][payment_source_type_eq]": {d.Get("payment_source_type").(string)},
+	}
+	if marketId, ok := d.GetOk("market_id"); ok {
+		query.Set("filter[q][market_id_eq]", marketId.(string))
+	}
+	if currencyCode, ok := d.GetOk("currency_code"); ok {
+		query.Set("filter[q][currency_code_eq]", currencyCode.(string))
+	}
+
+	paymentMethod, err := findResource(ctx, c, "/payment_methods", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	var attributes commercelayer.GETPaymentMethods200ResponseDataInnerAttributes
+	err = paymentMethod.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected payment method %s: %s", paymentMethod.Id, err)
+	}
+
+	d.SetId(paymentMethod.Id)
+
+	paymentGateway := paymentMethod.relationship("payment_gateway")
+	values := map[string]interface{}{
+		"market_id":            paymentMethod.relationship("market").Id,
+		"currency_code":        attributes.GetCurrencyCode(),
+		"moto":                 attributes.GetMoto(),
+		"disabled_at":          attributes.GetDisabledAt(),
+		"price_amount_cents":   int(attributes.GetPriceAmountCents()),
+		"reference":            attributes.GetReference(),
+		"reference_origin":     attributes.GetReferenceOrigin(),
+		"metadata":             stringMap(attributes.GetMetadata()),
+		"payment_gateway_id":   paymentGateway.Id,
+		"payment_gateway_type": paymentGateway.Type,
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourcePaymentMethod_basic() {
+	resourceName := "data.commercelayer_payment_method.incentro_payment_method"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPaymentMethodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccAdyenGatewayCreate(resourceName),
+					testAccPaymentMethodCreate(resourceName),
+					testAccDataSourcePaymentMethod()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"commercelayer_payment_method.incentro_payment_method", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "payment_gateway_id",
+						"commercelayer_adyen_gateway.incentro_adyen_gateway", "id"),
+					resource.TestCheckResourceAttr(resourceName, "payment_gateway_type", adyenGatewaysType),
+					resource.TestCheckResourceAttr(resourceName, "metadata.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePaymentMethod() string {
+	return `
+		data "commercelayer_payment_method" "incentro_payment_method" {
+		  payment_source_type = commercelayer_payment_method.incentro_payment_method.attributes[0].payment_source_type
+		  currency_code       = commercelayer_payment_method.incentro_payment_method.attributes[0].currency_code
+		}
+	`
+}
@@ -0,0 +1,117 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourcePriceList() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing price list by id, name or currency code. This is useful to reference price " +
+			"lists that are owned by another team or workspace, e.g. from a market.",
+		ReadContext: dataSourcePriceListReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:   "The price list unique identifier",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name", "currency_code"},
+				AtLeastOneOf:  []string{"id", "name", "currency_code"},
+			},
+			"name": {
+				Description:  "The price list name. Combined with the currency code if both are set.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name", "currency_code"},
+			},
+			"currency_code": {
+				Description: "The international 3-letter currency code as defined by the ISO 4217 standard. Exactly " +
+					"one price list must match the given name and currency code.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: []string{"id", "name", "currency_code"},
+			},
+			"tax_included": {
+				Description: "Indicates if the associated prices include taxes",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"reference": {
+				Description: "The external identifier of the price list",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the price list",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourcePriceListReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	var priceList *jsonApiResource
+	if id, ok := d.GetOk("id"); ok {
+		resource, err := getResource(ctx, c, "/price_lists/"+id.(string), nil)
+		if err != nil {
+			return diagErr(err)
+		}
+		priceList = resource
+	} else {
+		query := url.Values{}
+		if name, ok := d.GetOk("name"); ok {
+			query.Set("filter[q][name_eq]", name.(string))
+		}
+		if currencyCode, ok := d.GetOk("currency_code"); ok {
+			query.Set("filter[q][currency_code_eq]", currencyCode.(string))
+		}
+
+		resource, err := findResource(ctx, c, "/price_lists", query)
+		if err != nil {
+			return diagErr(err)
+		}
+		priceList = resource
+	}
+
+	var attributes commercelayer.GETPriceLists200ResponseDataInnerAttributes
+	err := priceList.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected price list %s: %s", priceList.Id, err)
+	}
+
+	d.SetId(priceList.Id)
+
+	values := map[string]interface{}{
+		"name":             attributes.GetName(),
+		"currency_code":    attributes.GetCurrencyCode(),
+		"tax_included":     attributes.GetTaxIncluded(),
+		"reference":        attributes.GetReference(),
+		"reference_origin": attributes.GetReferenceOrigin(),
+		"metadata":         stringMap(attributes.GetMetadata()),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourcePriceList_basic() {
+	resourceName := "data.commercelayer_price_list.incentro_price_list"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPriceListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccPriceListCreate(resourceName),
+					testAccDataSourcePriceList()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"commercelayer_price_list.incentro_price_list", "id"),
+					resource.TestCheckResourceAttr(resourceName, "tax_included", "true"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePriceList() string {
+	return `
+		data "commercelayer_price_list" "incentro_price_list" {
+		  name          = commercelayer_price_list.incentro_price_list.attributes[0].name
+		  currency_code = commercelayer_price_list.incentro_price_list.attributes[0].currency_code
+		}
+	`
+}
@@ -0,0 +1,143 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourcePriceTiers() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "List the tiers of a price, of any kind, sorted by their upper limit. This is useful to compare " +
+			"the actual tier ladder of a price with the intended one in pricing validation checks.",
+		ReadContext: dataSourcePriceTiersReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The identifier of the applied filters",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filter": queryFilterSchema(),
+			"price_id": {
+				Description: "The id of the price to list the tiers of",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"price_tiers": {
+				Description: "The tiers of the price, sorted by their upper limit",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The price tier unique identifier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "The price tier type, e.g. price_volume_tiers",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The price tier name",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"up_to": {
+							Description: "The tier upper limit, 0 for the last tier which has no upper limit",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"price_amount_cents": {
+							Description: "The price of the tier, in cents",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"reference": {
+							Description: "The external identifier of the price tier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference_origin": {
+							Description: "The identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"metadata": {
+							Description: "The key-value pairs attached to the price tier",
+							Type:        schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for key, value := range queryPaginationSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourcePriceTiersReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	filters := url.Values{
+		"filter[q][price_id_eq]": {d.Get("price_id").(string)},
+	}
+
+	if err := expandQueryFilters(d, filters); err != nil {
+		return diagErr(err)
+	}
+
+	query := url.Values{"sort": {"up_to"}}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	resources, truncated, err := listDataSourceResources(ctx, c, d, "/price_tiers", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	priceTiers := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		var attributes commercelayer.GETPriceTiers200ResponseDataInnerAttributes
+		err := resource.decodeAttributes(&attributes)
+		if err != nil {
+			return diag.Errorf("unexpected price tier %s: %s", resource.Id, err)
+		}
+
+		priceTiers = append(priceTiers, map[string]interface{}{
+			"id":                 resource.Id,
+			"type":               resource.Type,
+			"name":               attributes.GetName(),
+			"up_to":              float64(attributes.GetUpTo()),
+			"price_amount_cents": int(attributes.GetPriceAmountCents()),
+			"reference":          attributes.GetReference(),
+			"reference_origin":   attributes.GetReferenceOrigin(),
+			"metadata":           stringMap(attributes.GetMetadata()),
+		})
+	}
+
+	d.SetId(queryId(filters))
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diagErr(err)
+	}
+
+	if err := d.Set("price_tiers", priceTiers); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourcePriceTiersRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourcePriceTiers(), map[string]interface{}{
+		"price_id": "price-tshirt",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/price_tiers", r.URL.Path)
+		assert.Equal(t, "price-tshirt", r.URL.Query().Get("filter[q][price_id_eq]"))
+		assert.Equal(t, "up_to", r.URL.Query().Get("sort"))
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "tier-10",
+				"type": "price_volume_tiers",
+				"attributes": {"name": "Up to 10", "up_to": 10, "price_amount_cents": 1000}
+			}, {
+				"id": "tier-rest",
+				"type": "price_volume_tiers",
+				"attributes": {"name": "More than 10", "up_to": null, "price_amount_cents": 800}
+			}],
+			"meta": {"record_count": 2, "page_count": 1}
+		}`))
+	})
+
+	diags := dataSourcePriceTiersReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 2, d.Get("price_tiers.#"))
+	assert.Equal(t, "price_volume_tiers", d.Get("price_tiers.0.type"))
+	assert.Equal(t, 10.0, d.Get("price_tiers.0.up_to"))
+	assert.Equal(t, 0.0, d.Get("price_tiers.1.up_to"))
+	assert.Equal(t, 800, d.Get("price_tiers.1.price_amount_cents"))
+}
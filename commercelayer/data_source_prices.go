@@ -0,0 +1,172 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourcePrices() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "List the prices of a SKU across all the price lists, or in a single price list. This is useful " +
+			"to expose current prices through terraform outputs, e.g. for pricing validation pipelines.",
+		ReadContext: dataSourcePricesReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The identifier of the applied filters",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filter": queryFilterSchema(),
+			"sku_code": {
+				Description: "The code of the SKU to list the prices of",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"price_list_id": {
+				Description: "Only list the price of the SKU in this price list",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"prices": {
+				Description: "The matching prices, sorted by currency code",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The price unique identifier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"price_list_id": {
+							Description: "The associated price list id",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"sku_id": {
+							Description: "The associated SKU id",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"currency_code": {
+							Description: "The international 3-letter currency code as defined by the ISO 4217 standard",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"amount_cents": {
+							Description: "The SKU price amount for the price list, in cents",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"formatted_amount": {
+							Description: "The SKU price amount for the price list, formatted",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"compare_at_amount_cents": {
+							Description: "The compared price amount, in cents, useful to display a discount",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"formatted_compare_at_amount": {
+							Description: "The compared price amount, formatted",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference": {
+							Description: "The external identifier of the price",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference_origin": {
+							Description: "The identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"metadata": {
+							Description: "The key-value pairs attached to the price",
+							Type:        schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for key, value := range queryPaginationSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourcePricesReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	filters := url.Values{
+		"filter[q][sku_code_eq]": {d.Get("sku_code").(string)},
+	}
+	if priceListId, ok := d.GetOk("price_list_id"); ok {
+		filters.Set("filter[q][price_list_id_eq]", priceListId.(string))
+	}
+
+	if err := expandQueryFilters(d, filters); err != nil {
+		return diagErr(err)
+	}
+
+	query := url.Values{
+		"include": {"price_list,sku"},
+		"sort":    {"currency_code"},
+	}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	resources, truncated, err := listDataSourceResources(ctx, c, d, "/prices", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	prices := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		var attributes commercelayer.GETPrices200ResponseDataInnerAttributes
+		err := resource.decodeAttributes(&attributes)
+		if err != nil {
+			return diag.Errorf("unexpected price %s: %s", resource.Id, err)
+		}
+
+		prices = append(prices, map[string]interface{}{
+			"id":                          resource.Id,
+			"price_list_id":               resource.relationship("price_list").Id,
+			"sku_id":                      resource.relationship("sku").Id,
+			"currency_code":               attributes.GetCurrencyCode(),
+			"amount_cents":                int(attributes.GetAmountCents()),
+			"formatted_amount":            attributes.GetFormattedAmount(),
+			"compare_at_amount_cents":     int(attributes.GetCompareAtAmountCents()),
+			"formatted_compare_at_amount": attributes.GetFormattedCompareAtAmount(),
+			"reference":                   attributes.GetReference(),
+			"reference_origin":            attributes.GetReferenceOrigin(),
+			"metadata":                    stringMap(attributes.GetMetadata()),
+		})
+	}
+
+	d.SetId(queryId(filters))
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diagErr(err)
+	}
+
+	if err := d.Set("prices", prices); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
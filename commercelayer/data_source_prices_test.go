@@ -0,0 +1,46 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourcePricesRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourcePrices(), map[string]interface{}{
+		"sku_code": "TSHIRT-M",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/prices", r.URL.Path)
+		assert.Equal(t, "TSHIRT-M", r.URL.Query().Get("filter[q][sku_code_eq]"))
+		assert.Equal(t, "", r.URL.Query().Get("filter[q][price_list_id_eq]"))
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "price-chf",
+				"type": "prices",
+				"attributes": {"currency_code": "CHF", "sku_code": "TSHIRT-M", "amount_cents": 2500},
+				"relationships": {"price_list": {"data": {"id": "price-list-chf", "type": "price_lists"}}}
+			}, {
+				"id": "price-eur",
+				"type": "prices",
+				"attributes": {
+					"currency_code": "EUR", "sku_code": "TSHIRT-M", "amount_cents": 1990,
+					"formatted_amount": "€19,90", "compare_at_amount_cents": 2490
+				},
+				"relationships": {"price_list": {"data": {"id": "price-list-eur", "type": "price_lists"}}}
+			}],
+			"meta": {"record_count": 2, "page_count": 1}
+		}`))
+	})
+
+	diags := dataSourcePricesReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, 2, d.Get("prices.#"))
+	assert.Equal(t, "price-list-eur", d.Get("prices.1.price_list_id"))
+	assert.Equal(t, 1990, d.Get("prices.1.amount_cents"))
+	assert.Equal(t, 2490, d.Get("prices.1.compare_at_amount_cents"))
+	assert.Equal(t, "€19,90", d.Get("prices.1.formatted_amount"))
+}
@@ -0,0 +1,215 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourcePromotions() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "List the promotions of any kind, optionally filtered by status, market and date range. This " +
+			"is useful to audit which promotions are active before toggling markets.",
+		ReadContext: dataSourcePromotionsReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The identifier of the applied filters",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filter": queryFilterSchema(),
+			"status": {
+				Description: "Only list the promotions that are currently 'active', or 'inactive', i.e. not " +
+					"started yet, expired or over their usage limit",
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringInSlice([]string{"active", "inactive"}, false)),
+			},
+			"market_id": {
+				Description: "Only list the promotions of this market",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"active_from": {
+				Description:      "Only list the promotions that don't expire before this time, in RFC 3339 format",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			},
+			"active_until": {
+				Description:      "Only list the promotions that start before this time, in RFC 3339 format",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+			},
+			"promotions": {
+				Description: "The matching promotions, sorted by start time",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The promotion unique identifier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"type": {
+							Description: "The promotion type, e.g. percentage_discount_promotions",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The promotion's internal name",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"currency_code": {
+							Description: "The international 3-letter currency code as defined by the ISO 4217 standard",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"starts_at": {
+							Description: "The activation date/time of the promotion",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"expires_at": {
+							Description: "The expiration date/time of the promotion",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"total_usage_limit": {
+							Description: "The total number of times the promotion can be applied",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"total_usage_count": {
+							Description: "The number of times the promotion has been applied",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"active": {
+							Description: "Indicates if the promotion is currently active",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"market_id": {
+							Description: "The associated market id, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference": {
+							Description: "The external identifier of the promotion",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference_origin": {
+							Description: "The identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"metadata": {
+							Description: "The key-value pairs attached to the promotion",
+							Type:        schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for key, value := range queryPaginationSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourcePromotionsReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	filters := url.Values{}
+	if marketId, ok := d.GetOk("market_id"); ok {
+		filters.Set("filter[q][market_id_eq]", marketId.(string))
+	}
+	if activeFrom, ok := d.GetOk("active_from"); ok {
+		filters.Set("filter[q][expires_at_gteq]", activeFrom.(string))
+	}
+	if activeUntil, ok := d.GetOk("active_until"); ok {
+		filters.Set("filter[q][starts_at_lteq]", activeUntil.(string))
+	}
+
+	if err := expandQueryFilters(d, filters); err != nil {
+		return diagErr(err)
+	}
+
+	query := url.Values{
+		"include": {"market"},
+		"sort":    {"starts_at"},
+	}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	resources, truncated, err := listDataSourceResources(ctx, c, d, "/promotions", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	// the status is filtered here, as the active attribute is computed by the API and can't be queried
+	status, filterStatus := d.GetOk("status")
+	if filterStatus {
+		filters.Set("status", status.(string))
+	}
+
+	promotions := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		// the attributes common to all the promotions, the SDK uses the free shipping ones for the polymorphic endpoint
+		var attributes commercelayer.GETFreeShippingPromotions200ResponseDataInnerAttributes
+		err := resource.decodeAttributes(&attributes)
+		if err != nil {
+			return diag.Errorf("unexpected promotion %s: %s", resource.Id, err)
+		}
+
+		if filterStatus && attributes.GetActive() != (status == "active") {
+			continue
+		}
+
+		promotions = append(promotions, map[string]interface{}{
+			"id":                resource.Id,
+			"type":              resource.Type,
+			"name":              attributes.GetName(),
+			"currency_code":     attributes.GetCurrencyCode(),
+			"starts_at":         attributes.GetStartsAt(),
+			"expires_at":        attributes.GetExpiresAt(),
+			"total_usage_limit": int(attributes.GetTotalUsageLimit()),
+			"total_usage_count": int(attributes.GetTotalUsageCount()),
+			"active":            attributes.GetActive(),
+			"market_id":         resource.relationship("market").Id,
+			"reference":         attributes.GetReference(),
+			"reference_origin":  attributes.GetReferenceOrigin(),
+			"metadata":          stringMap(attributes.GetMetadata()),
+		})
+	}
+
+	d.SetId(queryId(filters))
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diagErr(err)
+	}
+
+	if err := d.Set("promotions", promotions); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourcePromotionsRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourcePromotions(), map[string]interface{}{
+		"status":      "active",
+		"market_id":   "xYZkjABcde",
+		"active_from": "2023-06-01T00:00:00Z",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/promotions", r.URL.Path)
+		assert.Equal(t, "xYZkjABcde", r.URL.Query().Get("filter[q][market_id_eq]"))
+		assert.Equal(t, "2023-06-01T00:00:00Z", r.URL.Query().Get("filter[q][expires_at_gteq]"))
+		assert.Equal(t, "", r.URL.Query().Get("filter[q][starts_at_lteq]"))
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "summer-sale",
+				"type": "percentage_discount_promotions",
+				"attributes": {"name": "Summer sale", "active": true, "starts_at": "2023-06-01T00:00:00Z"},
+				"relationships": {"market": {"data": {"id": "xYZkjABcde", "type": "markets"}}}
+			}, {
+				"id": "free-shipping",
+				"type": "free_shipping_promotions",
+				"attributes": {"name": "Free shipping", "active": false, "starts_at": "2023-07-01T00:00:00Z"},
+				"relationships": {"market": {"data": {"id": "xYZkjABcde", "type": "markets"}}}
+			}],
+			"meta": {"record_count": 2, "page_count": 1}
+		}`))
+	})
+
+	diags := dataSourcePromotionsReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.NotEmpty(t, d.Id())
+	assert.Equal(t, 1, d.Get("promotions.#"))
+	assert.Equal(t, "summer-sale", d.Get("promotions.0.id"))
+	assert.Equal(t, "percentage_discount_promotions", d.Get("promotions.0.type"))
+	assert.Equal(t, "xYZkjABcde", d.Get("promotions.0.market_id"))
+}
@@ -0,0 +1,70 @@
+package commercelayer
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apiVersion is the Commerce Layer API version the SDK used by this provider has been generated from
+const apiVersion = "3.4.0"
+
+func dataSourceProviderInfo(version string) *schema.Resource {
+	return &schema.Resource{
+		Description: "Information about the provider build itself, like its version, the Commercelayer API version " +
+			"it targets and the resource types it supports. This can be used by modules to enforce a minimum " +
+			"provider version or the availability of a resource with a helpful message.",
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			return dataSourceProviderInfoReadFunc(d, version)
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The provider version",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"provider_version": {
+				Description: "The version of the provider build, or dev when built from source",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"api_version": {
+				Description: "The Commercelayer API version the provider targets",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"resource_types": {
+				Description: "The resource types supported by the provider, sorted alphabetically",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceProviderInfoReadFunc(d *schema.ResourceData, version string) diag.Diagnostics {
+	var resourceTypes []string
+	for name := range baseResourceMap {
+		resourceTypes = append(resourceTypes, name)
+	}
+	sort.Strings(resourceTypes)
+
+	d.SetId(version)
+
+	if err := d.Set("provider_version", version); err != nil {
+		return diagErr(err)
+	}
+	if err := d.Set("api_version", apiVersion); err != nil {
+		return diagErr(err)
+	}
+	if err := d.Set("resource_types", resourceTypes); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package commercelayer
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccProviderInfo_basic() {
+	resourceName := "data.commercelayer_provider_info.incentro_provider_info"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "commercelayer_provider_info" "incentro_provider_info" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "provider_version", "dev"),
+					resource.TestCheckResourceAttr(resourceName, "api_version", apiVersion),
+					resource.TestCheckResourceAttr(resourceName, "resource_types.0", "commercelayer_address"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,95 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceShippingCategory() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing shipping category by id or name. This is useful to reference shipping " +
+			"categories that are not managed by terraform, e.g. from SKUs.",
+		ReadContext: dataSourceShippingCategoryReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The shipping category unique identifier",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The shipping category name, which must match exactly one shipping category",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"reference": {
+				Description: "The external identifier of the shipping category",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the shipping category",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceShippingCategoryReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	var shippingCategory *jsonApiResource
+	if id, ok := d.GetOk("id"); ok {
+		resource, err := getResource(ctx, c, "/shipping_categories/"+id.(string), nil)
+		if err != nil {
+			return diagErr(err)
+		}
+		shippingCategory = resource
+	} else {
+		resource, err := findResource(ctx, c, "/shipping_categories", url.Values{
+			"filter[q][name_eq]": {d.Get("name").(string)},
+		})
+		if err != nil {
+			return diagErr(err)
+		}
+		shippingCategory = resource
+	}
+
+	var attributes commercelayer.GETShippingCategories200ResponseDataInnerAttributes
+	err := shippingCategory.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected shipping category %s: %s", shippingCategory.Id, err)
+	}
+
+	d.SetId(shippingCategory.Id)
+
+	values := map[string]interface{}{
+		"name":             attributes.GetName(),
+		"reference":        attributes.GetReference(),
+		"reference_origin": attributes.GetReferenceOrigin(),
+		"metadata":         stringMap(attributes.GetMetadata()),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
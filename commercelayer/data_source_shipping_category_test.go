@@ -0,0 +1,40 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceShippingCategory_basic() {
+	resourceName := "data.commercelayer_shipping_category.incentro_shipping_category"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckShippingCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccShippingCategoryCreate(resourceName),
+					testAccDataSourceShippingCategory()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"commercelayer_shipping_category.incentro_shipping_category", "id"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceShippingCategory() string {
+	return `
+		data "commercelayer_shipping_category" "incentro_shipping_category" {
+		  name = commercelayer_shipping_category.incentro_shipping_category.attributes[0].name
+		}
+	`
+}
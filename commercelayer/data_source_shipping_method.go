@@ -0,0 +1,175 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+// shippingMethodIncludes are the relationships included when reading shipping methods, so that their ids are returned
+const shippingMethodIncludes = "market,shipping_zone,shipping_category,stock_location"
+
+func dataSourceShippingMethod() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing shipping method by name, optionally scoped to a market. This is useful " +
+			"for modules that only manage the delivery lead times or tiers of shipping methods managed elsewhere.",
+		ReadContext: dataSourceShippingMethodReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The shipping method unique identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The shipping method name",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"market_id": {
+				Description: "The market of the shipping method. Exactly one shipping method must match the name " +
+					"and, when set, the market.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"scheme": {
+				Description: "The shipping method's scheme, one of 'flat' or 'weight_tiered'",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"currency_code": {
+				Description: "The international 3-letter currency code as defined by the ISO 4217 standard",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"disabled_at": {
+				Description: "Time at which the shipping method was disabled, empty while it is enabled",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"price_amount_cents": {
+				Description: "The price of the shipping method, in cents",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"formatted_price_amount": {
+				Description: "The price of the shipping method, formatted",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"free_over_amount_cents": {
+				Description: "The order amount over which the shipping method is free, in cents",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"formatted_free_over_amount": {
+				Description: "The order amount over which the shipping method is free, formatted",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"min_weight": {
+				Description: "The minimum weight for which the shipping method is available",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"max_weight": {
+				Description: "The maximum weight for which the shipping method is available",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"unit_of_weight": {
+				Description: "The unit of weight, one of 'gr', 'lb', or 'oz'",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference": {
+				Description: "The external identifier of the shipping method",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the shipping method",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"shipping_zone_id": {
+				Description: "The shipping zone the shipping method is available in, if any",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"shipping_category_id": {
+				Description: "The shipping category the shipping method is restricted to, if any",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"stock_location_id": {
+				Description: "The stock location the shipping method is restricted to, if any",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceShippingMethodReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	query := url.Values{
+		"include":            {shippingMethodIncludes},
+		"filter[q][name_eq]": {d.Get("name").(string)},
+	}
+	if marketId, ok := d.GetOk("market_id"); ok {
+		query.Set("filter[q][market_id_eq]", marketId.(string))
+	}
+
+	shippingMethod, err := findResource(ctx, c, "/shipping_methods", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	var attributes commercelayer.GETShippingMethods200ResponseDataInnerAttributes
+	err = shippingMethod.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected shipping method %s: %s", shippingMethod.Id, err)
+	}
+
+	d.SetId(shippingMethod.Id)
+
+	values := map[string]interface{}{
+		"market_id":                  shippingMethod.relationship("market").Id,
+		"scheme":                     attributes.GetScheme(),
+		"currency_code":              attributes.GetCurrencyCode(),
+		"disabled_at":                attributes.GetDisabledAt(),
+		"price_amount_cents":         int(attributes.GetPriceAmountCents()),
+		"formatted_price_amount":     attributes.GetFormattedPriceAmount(),
+		"free_over_amount_cents":     int(attributes.GetFreeOverAmountCents()),
+		"formatted_free_over_amount": attributes.GetFormattedFreeOverAmount(),
+		"min_weight":                 float64(attributes.GetMinWeight()),
+		"max_weight":                 float64(attributes.GetMaxWeight()),
+		"unit_of_weight":             attributes.GetUnitOfWeight(),
+		"reference":                  attributes.GetReference(),
+		"reference_origin":           attributes.GetReferenceOrigin(),
+		"metadata":                   stringMap(attributes.GetMetadata()),
+		"shipping_zone_id":           shippingMethod.relationship("shipping_zone").Id,
+		"shipping_category_id":       shippingMethod.relationship("shipping_category").Id,
+		"stock_location_id":          shippingMethod.relationship("stock_location").Id,
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
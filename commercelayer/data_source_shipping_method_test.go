@@ -0,0 +1,42 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceShippingMethod_basic() {
+	resourceName := "data.commercelayer_shipping_method.incentro_shipping_method"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckShippingMethodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccShippingMethodCreate(resourceName),
+					testAccDataSourceShippingMethod()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"commercelayer_shipping_method.incentro_shipping_method", "id"),
+					resource.TestCheckResourceAttr(resourceName, "price_amount_cents", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "free_over_amount_cents", "10000"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceShippingMethod() string {
+	return `
+		data "commercelayer_shipping_method" "incentro_shipping_method" {
+		  name = commercelayer_shipping_method.incentro_shipping_method.attributes[0].name
+		}
+	`
+}
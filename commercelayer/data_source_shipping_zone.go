@@ -0,0 +1,131 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceShippingZone() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing shipping zone by id or name. This is useful to attach shipping methods " +
+			"to zones that are managed elsewhere.",
+		ReadContext: dataSourceShippingZoneReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The shipping zone unique identifier",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The shipping zone name, which must match exactly one shipping zone",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"country_code_regex": {
+				Description: "The regex that will be evaluated to match the shipping address country code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"not_country_code_regex": {
+				Description: "The regex that will be evaluated as negative match for the shipping address country code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"state_code_regex": {
+				Description: "The regex that will be evaluated to match the shipping address state code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"not_state_code_regex": {
+				Description: "The regex that will be evaluated as negative match for the shipping address state code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"zip_code_regex": {
+				Description: "The regex that will be evaluated to match the shipping address zip code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"not_zip_code_regex": {
+				Description: "The regex that will be evaluated as negative match for the shipping address zip code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference": {
+				Description: "The external identifier of the shipping zone",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the shipping zone",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceShippingZoneReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	var shippingZone *jsonApiResource
+	if id, ok := d.GetOk("id"); ok {
+		resource, err := getResource(ctx, c, "/shipping_zones/"+id.(string), nil)
+		if err != nil {
+			return diagErr(err)
+		}
+		shippingZone = resource
+	} else {
+		resource, err := findResource(ctx, c, "/shipping_zones", url.Values{
+			"filter[q][name_eq]": {d.Get("name").(string)},
+		})
+		if err != nil {
+			return diagErr(err)
+		}
+		shippingZone = resource
+	}
+
+	var attributes commercelayer.GETShippingZones200ResponseDataInnerAttributes
+	err := shippingZone.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected shipping zone %s: %s", shippingZone.Id, err)
+	}
+
+	d.SetId(shippingZone.Id)
+
+	values := map[string]interface{}{
+		"name":                   attributes.GetName(),
+		"country_code_regex":     attributes.GetCountryCodeRegex(),
+		"not_country_code_regex": attributes.GetNotCountryCodeRegex(),
+		"state_code_regex":       attributes.GetStateCodeRegex(),
+		"not_state_code_regex":   attributes.GetNotStateCodeRegex(),
+		"zip_code_regex":         attributes.GetZipCodeRegex(),
+		"not_zip_code_regex":     attributes.GetNotZipCodeRegex(),
+		"reference":              attributes.GetReference(),
+		"reference_origin":       attributes.GetReferenceOrigin(),
+		"metadata":               stringMap(attributes.GetMetadata()),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceShippingZone_basic() {
+	resourceName := "data.commercelayer_shipping_zone.incentro_shipping_zone"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckShippingZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccShippingZoneCreate(resourceName),
+					testAccDataSourceShippingZone()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"commercelayer_shipping_zone.incentro_shipping_zone", "id"),
+					resource.TestCheckResourceAttr(resourceName, "country_code_regex", ".*"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceShippingZone() string {
+	return `
+		data "commercelayer_shipping_zone" "incentro_shipping_zone" {
+		  name = commercelayer_shipping_zone.incentro_shipping_zone.attributes[0].name
+		}
+	`
+}
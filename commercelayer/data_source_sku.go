@@ -0,0 +1,167 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+// skuIncludes are the relationships included when reading SKUs, so that their ids are returned
+const skuIncludes = "shipping_category"
+
+func dataSourceSku() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "Look up an existing SKU by its code. This is useful to reference catalog items that are not " +
+			"managed by terraform, e.g. from promotions or bundles.",
+		ReadContext: dataSourceSkuReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The SKU unique identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"code": {
+				Description: "The SKU code",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+
+	for key, value := range skuDataSourceSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourceSkuReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	sku, err := findResource(ctx, c, "/skus", url.Values{
+		"include":            {skuIncludes},
+		"filter[q][code_eq]": {d.Get("code").(string)},
+	})
+	if err != nil {
+		return diagErr(err)
+	}
+
+	values, err := flattenSku(*sku)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	d.SetId(sku.Id)
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
+
+// flattenSku returns the data source values of a SKU read with the skuIncludes
+func flattenSku(sku jsonApiResource) (map[string]interface{}, error) {
+	var attributes commercelayer.GETSkus200ResponseDataInnerAttributes
+	err := sku.decodeAttributes(&attributes)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected sku %s: %w", sku.Id, err)
+	}
+
+	return map[string]interface{}{
+		"code":                 attributes.GetCode(),
+		"name":                 attributes.GetName(),
+		"description":          attributes.GetDescription(),
+		"image_url":            attributes.GetImageUrl(),
+		"pieces_per_pack":      int(attributes.GetPiecesPerPack()),
+		"weight":               float64(attributes.GetWeight()),
+		"unit_of_weight":       attributes.GetUnitOfWeight(),
+		"hs_tariff_number":     attributes.GetHsTariffNumber(),
+		"do_not_ship":          attributes.GetDoNotShip(),
+		"do_not_track":         attributes.GetDoNotTrack(),
+		"reference":            attributes.GetReference(),
+		"reference_origin":     attributes.GetReferenceOrigin(),
+		"metadata":             stringMap(attributes.GetMetadata()),
+		"shipping_category_id": sku.relationship("shipping_category").Id,
+	}, nil
+}
+
+// skuDataSourceSchema returns the computed attributes of a SKU, shared by the sku and skus data sources
+func skuDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Description: "The internal name of the SKU",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: "An internal description of the SKU",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"image_url": {
+			Description: "The URL of an image that represents the SKU",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"pieces_per_pack": {
+			Description: "The number of pieces that compose the SKU",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"weight": {
+			Description: "The weight of the SKU",
+			Type:        schema.TypeFloat,
+			Computed:    true,
+		},
+		"unit_of_weight": {
+			Description: "The unit of weight, one of 'gr', 'lb', or 'oz'",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"hs_tariff_number": {
+			Description: "The Harmonized System Code used by customs",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"do_not_ship": {
+			Description: "Indicates if the SKU doesn't generate shipments",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"do_not_track": {
+			Description: "Indicates if the SKU doesn't track the stock inventory",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+		"reference": {
+			Description: "The external identifier of the SKU",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"reference_origin": {
+			Description: "The identifier of the third party system that defines the reference code",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"metadata": {
+			Description: "The key-value pairs attached to the SKU",
+			Type:        schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Computed: true,
+		},
+		"shipping_category_id": {
+			Description: "The associated shipping category id",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
@@ -0,0 +1,208 @@
+package commercelayer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+// skuInventory is the aggregated inventory Commercelayer returns when retrieving a single SKU with a market scoped
+// access token
+type skuInventory struct {
+	Available bool `json:"available"`
+	Quantity  int  `json:"quantity"`
+	Levels    []struct {
+		Quantity          int `json:"quantity"`
+		DeliveryLeadTimes []struct {
+			ShippingMethod struct {
+				Name      string `json:"name"`
+				Reference string `json:"reference"`
+			} `json:"shipping_method"`
+			Min struct {
+				Hours int `json:"hours"`
+				Days  int `json:"days"`
+			} `json:"min"`
+			Max struct {
+				Hours int `json:"hours"`
+				Days  int `json:"days"`
+			} `json:"max"`
+		} `json:"delivery_lead_times"`
+	} `json:"levels"`
+}
+
+func dataSourceSkuAvailability(c *Configuration) *schema.Resource {
+	return &schema.Resource{
+		Description: "The availability of a SKU in a market, as computed by Commercelayer from the stock of the " +
+			"inventory model of the market. This can be used to assert that key products are still sellable " +
+			"after infrastructure changes.",
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			return dataSourceSkuAvailabilityReadFunc(ctx, d, c.marketClient(d.Get("market_id").(string)))
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The SKU id and market id, separated by a slash",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"sku_id": {
+				Description: "The SKU id",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"market_id": {
+				Description: "The id of the market the availability is computed for",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"available": {
+				Description: "Indicates if the SKU can be sold in the market",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"quantity": {
+				Description: "The quantity available in the market",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"levels": {
+				Description: "The available quantity per stock location level of the inventory model, in order",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"quantity": {
+							Description: "The quantity available at this level",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"delivery_lead_times": {
+							Description: "The delivery lead times of the shipping methods available at this level",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"shipping_method_name": {
+										Description: "The shipping method name",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"shipping_method_reference": {
+										Description: "The shipping method reference",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"min_hours": {
+										Description: "The minimum delivery time in hours",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"min_days": {
+										Description: "The minimum delivery time in days",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"max_hours": {
+										Description: "The maximum delivery time in hours",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+									"max_days": {
+										Description: "The maximum delivery time in days",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// dataSourceSkuAvailabilityReadFunc reads the availability with a client scoped to the market, as the inventory of a
+// SKU is only computed for the market the access token is scoped to
+func dataSourceSkuAvailabilityReadFunc(ctx context.Context, d *schema.ResourceData, c *commercelayer.APIClient) diag.Diagnostics {
+	skuId := d.Get("sku_id").(string)
+	marketId := d.Get("market_id").(string)
+
+	resp, _, err := c.SkusApi.GETSkusSkuId(ctx, skuId).Execute()
+	if err != nil {
+		return diagErr(err)
+	}
+
+	sku, ok := resp.GetDataOk()
+	if !ok {
+		return diag.Errorf("sku %s not found", skuId)
+	}
+
+	attributes := sku.GetAttributes()
+
+	// No inventory is returned for SKUs that do not track their stock, those can always be sold
+	inventory := skuInventory{Available: attributes.GetDoNotTrack()}
+	if rawInventory, ok := attributes.GetInventoryOk(); ok {
+		inventory, err = parseSkuInventory(rawInventory)
+		if err != nil {
+			return diagErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", skuId, marketId))
+
+	if err := d.Set("available", inventory.Available); err != nil {
+		return diagErr(err)
+	}
+	if err := d.Set("quantity", inventory.Quantity); err != nil {
+		return diagErr(err)
+	}
+	if err := d.Set("levels", flattenSkuInventoryLevels(inventory)); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
+
+func parseSkuInventory(raw map[string]interface{}) (skuInventory, error) {
+	var inventory skuInventory
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return inventory, err
+	}
+
+	err = json.Unmarshal(b, &inventory)
+	if err != nil {
+		return inventory, fmt.Errorf("unexpected sku inventory: %w", err)
+	}
+
+	return inventory, nil
+}
+
+func flattenSkuInventoryLevels(inventory skuInventory) []interface{} {
+	levels := make([]interface{}, 0, len(inventory.Levels))
+	for _, level := range inventory.Levels {
+		deliveryLeadTimes := make([]interface{}, 0, len(level.DeliveryLeadTimes))
+		for _, deliveryLeadTime := range level.DeliveryLeadTimes {
+			deliveryLeadTimes = append(deliveryLeadTimes, map[string]interface{}{
+				"shipping_method_name":      deliveryLeadTime.ShippingMethod.Name,
+				"shipping_method_reference": deliveryLeadTime.ShippingMethod.Reference,
+				"min_hours":                 deliveryLeadTime.Min.Hours,
+				"min_days":                  deliveryLeadTime.Min.Days,
+				"max_hours":                 deliveryLeadTime.Max.Hours,
+				"max_days":                  deliveryLeadTime.Max.Days,
+			})
+		}
+
+		levels = append(levels, map[string]interface{}{
+			"quantity":            level.Quantity,
+			"delivery_lead_times": deliveryLeadTimes,
+		})
+	}
+
+	return levels
+}
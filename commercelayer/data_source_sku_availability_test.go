@@ -0,0 +1,155 @@
+package commercelayer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/incentro-dc/terraform-provider-commercelayer/client"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceSkuAvailability_basic() {
+	resourceName := "data.commercelayer_sku_availability.incentro_sku_availability"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSkuDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccAddressCreate(resourceName),
+					testAccInventoryModelCreate(resourceName),
+					testAccMerchantCreate(resourceName),
+					testAccPriceListCreate(resourceName),
+					testAccMarketCreate(resourceName),
+					testAccShippingCategoryCreate(resourceName),
+					testAccSkuCreate(resourceName),
+					testAccDataSourceSkuAvailability()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "sku_id", "commercelayer_sku.incentro_sku", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "market_id",
+						"commercelayer_market.incentro_market", "id"),
+					resource.TestCheckResourceAttr(resourceName, "available", "false"),
+					resource.TestCheckResourceAttr(resourceName, "quantity", "0"),
+					resource.TestCheckResourceAttr(resourceName, "levels.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSkuAvailability() string {
+	return `
+		data "commercelayer_sku_availability" "incentro_sku_availability" {
+		  sku_id    = commercelayer_sku.incentro_sku.id
+		  market_id = commercelayer_market.incentro_market.id
+		}
+	`
+}
+
+func TestParseSkuInventory(t *testing.T) {
+	var raw map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"available": true,
+		"quantity": 12,
+		"levels": [
+			{
+				"quantity": 12,
+				"delivery_lead_times": [
+					{
+						"shipping_method": {"name": "Standard", "reference": "standard"},
+						"min": {"hours": 48, "days": 2},
+						"max": {"hours": 72, "days": 3}
+					}
+				]
+			}
+		]
+	}`), &raw)
+	assert.NoError(t, err)
+
+	inventory, err := parseSkuInventory(raw)
+	assert.NoError(t, err)
+	assert.True(t, inventory.Available)
+	assert.Equal(t, 12, inventory.Quantity)
+
+	levels := flattenSkuInventoryLevels(inventory)
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"quantity": 12,
+			"delivery_lead_times": []interface{}{
+				map[string]interface{}{
+					"shipping_method_name":      "Standard",
+					"shipping_method_reference": "standard",
+					"min_hours":                 48,
+					"min_days":                  2,
+					"max_hours":                 72,
+					"max_days":                  3,
+				},
+			},
+		},
+	}, levels)
+}
+
+func TestMarketClient(t *testing.T) {
+	var scopes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth/token" {
+			assert.NoError(t, r.ParseForm())
+			scopes = append(scopes, r.PostForm.Get("scope"))
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"market-token","token_type":"Bearer","expires_in":7200}`))
+			return
+		}
+
+		assert.Equal(t, "/skus/xYZkjABcde", r.URL.Path)
+		assert.Equal(t, "Bearer market-token", r.Header.Get("Authorization"))
+
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = w.Write([]byte(`{
+			"data": {
+				"id": "xYZkjABcde",
+				"type": "skus",
+				"attributes": {"code": "TSHIRT-M", "inventory": {"available": true, "quantity": 3, "levels": []}}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	c := &Configuration{clientConfig: client.Config{
+		ClientId:     "client-id",
+		ClientSecret: "client-secret",
+		ApiEndpoint:  server.URL,
+		AuthEndpoint: server.URL + "/oauth/token",
+		// The token source of the provider can't be scoped, so it must not be used for the market
+		TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "provider-token"}),
+	}}
+
+	assert.Same(t, c.marketClient("BjQxbhJdvW"), c.marketClient("BjQxbhJdvW"))
+	assert.NotSame(t, c.marketClient("BjQxbhJdvW"), c.marketClient("dlQbPhNNop"))
+
+	for i := 0; i < 2; i++ {
+		d := schema.TestResourceDataRaw(t, dataSourceSkuAvailability(c).Schema, map[string]interface{}{
+			"sku_id":    "xYZkjABcde",
+			"market_id": "BjQxbhJdvW",
+		})
+
+		diags := dataSourceSkuAvailabilityReadFunc(context.Background(), d, c.marketClient("BjQxbhJdvW"))
+		assert.False(t, diags.HasError())
+		assert.Equal(t, "xYZkjABcde/BjQxbhJdvW", d.Id())
+		assert.Equal(t, 3, d.Get("quantity"))
+	}
+
+	assert.Equal(t, []string{"market:id:BjQxbhJdvW"}, scopes)
+}
@@ -0,0 +1,132 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceSkuList() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing SKU list by id, name or slug. This is useful to target lists curated by " +
+			"merchandisers in the dashboard, e.g. from promotions, without managing the list contents with terraform.",
+		ReadContext: dataSourceSkuListReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The SKU list unique identifier",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name", "slug"},
+			},
+			"name": {
+				Description:  "The SKU list internal name, which must match exactly one SKU list",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name", "slug"},
+			},
+			"slug": {
+				Description:  "The SKU list slug",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name", "slug"},
+			},
+			"description": {
+				Description: "An internal description of the SKU list",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"image_url": {
+				Description: "The URL of an image that represents the SKU list",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"manual": {
+				Description: "Indicates if the SKU list is populated manually, rather than by the SKU code regex",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"sku_code_regex": {
+				Description: "The regex that populates the SKU list, when it isn't manual",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference": {
+				Description: "The external identifier of the SKU list",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the SKU list",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSkuListReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	var skuList *jsonApiResource
+	if id, ok := d.GetOk("id"); ok {
+		resource, err := getResource(ctx, c, "/sku_lists/"+id.(string), nil)
+		if err != nil {
+			return diagErr(err)
+		}
+		skuList = resource
+	} else {
+		query := url.Values{}
+		if name, ok := d.GetOk("name"); ok {
+			query.Set("filter[q][name_eq]", name.(string))
+		} else {
+			query.Set("filter[q][slug_eq]", d.Get("slug").(string))
+		}
+
+		resource, err := findResource(ctx, c, "/sku_lists", query)
+		if err != nil {
+			return diagErr(err)
+		}
+		skuList = resource
+	}
+
+	var attributes commercelayer.GETSkuLists200ResponseDataInnerAttributes
+	err := skuList.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected sku list %s: %s", skuList.Id, err)
+	}
+
+	d.SetId(skuList.Id)
+
+	values := map[string]interface{}{
+		"name":             attributes.GetName(),
+		"slug":             attributes.GetSlug(),
+		"description":      attributes.GetDescription(),
+		"image_url":        attributes.GetImageUrl(),
+		"manual":           attributes.GetManual(),
+		"sku_code_regex":   attributes.GetSkuCodeRegex(),
+		"reference":        attributes.GetReference(),
+		"reference_origin": attributes.GetReferenceOrigin(),
+		"metadata":         stringMap(attributes.GetMetadata()),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
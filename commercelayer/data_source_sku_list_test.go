@@ -0,0 +1,34 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceSkuListRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourceSkuList(), map[string]interface{}{
+		"slug": "summer-sale",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/sku_lists", r.URL.Path)
+		assert.Equal(t, "summer-sale", r.URL.Query().Get("filter[q][slug_eq]"))
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "BjPLsKxNvE",
+				"type": "sku_lists",
+				"attributes": {"name": "Summer sale", "slug": "summer-sale", "manual": false, "sku_code_regex": "^SUMMER-"}
+			}],
+			"meta": {"record_count": 1, "page_count": 1}
+		}`))
+	})
+
+	diags := dataSourceSkuListReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "BjPLsKxNvE", d.Id())
+	assert.Equal(t, "Summer sale", d.Get("name"))
+	assert.Equal(t, false, d.Get("manual"))
+	assert.Equal(t, "^SUMMER-", d.Get("sku_code_regex"))
+}
@@ -0,0 +1,180 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceSkuOptions() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "List the SKU options, i.e. the customizations that can be added to line items, optionally " +
+			"filtered by market and name. This is useful for line item customization modules to enumerate the " +
+			"available options.",
+		ReadContext: dataSourceSkuOptionsReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The identifier of the applied filters",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filter": queryFilterSchema(),
+			"market_id": {
+				Description: "Only list the SKU options of this market",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"name": {
+				Description: "Only list the SKU options with this name",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"sku_options": {
+				Description: "The matching SKU options, sorted by name",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The SKU option unique identifier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The SKU option internal name",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"description": {
+							Description: "An internal description of the SKU option",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"currency_code": {
+							Description: "The international 3-letter currency code as defined by the ISO 4217 standard",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"price_amount_cents": {
+							Description: "The price of the SKU option, in cents",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"delay_hours": {
+							Description: "The delay in hours introduced by the SKU option on the delivery",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"delay_days": {
+							Description: "The delay in days introduced by the SKU option on the delivery",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"sku_code_regex": {
+							Description: "The regex of the SKU codes the option can be applied to",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"market_id": {
+							Description: "The associated market id, if any",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference": {
+							Description: "The external identifier of the SKU option",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference_origin": {
+							Description: "The identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"metadata": {
+							Description: "The key-value pairs attached to the SKU option",
+							Type:        schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for key, value := range queryPaginationSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourceSkuOptionsReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	filters := url.Values{}
+	if marketId, ok := d.GetOk("market_id"); ok {
+		filters.Set("filter[q][market_id_eq]", marketId.(string))
+	}
+	if name, ok := d.GetOk("name"); ok {
+		filters.Set("filter[q][name_eq]", name.(string))
+	}
+
+	if err := expandQueryFilters(d, filters); err != nil {
+		return diagErr(err)
+	}
+
+	query := url.Values{
+		"include": {"market"},
+		"sort":    {"name"},
+	}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	resources, truncated, err := listDataSourceResources(ctx, c, d, "/sku_options", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	skuOptions := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		var attributes commercelayer.GETSkuOptions200ResponseDataInnerAttributes
+		err := resource.decodeAttributes(&attributes)
+		if err != nil {
+			return diag.Errorf("unexpected sku option %s: %s", resource.Id, err)
+		}
+
+		skuOptions = append(skuOptions, map[string]interface{}{
+			"id":                 resource.Id,
+			"name":               attributes.GetName(),
+			"description":        attributes.GetDescription(),
+			"currency_code":      attributes.GetCurrencyCode(),
+			"price_amount_cents": int(attributes.GetPriceAmountCents()),
+			"delay_hours":        int(attributes.GetDelayHours()),
+			"delay_days":         int(attributes.GetDelayDays()),
+			"sku_code_regex":     attributes.GetSkuCodeRegex(),
+			"market_id":          resource.relationship("market").Id,
+			"reference":          attributes.GetReference(),
+			"reference_origin":   attributes.GetReferenceOrigin(),
+			"metadata":           stringMap(attributes.GetMetadata()),
+		})
+	}
+
+	d.SetId(queryId(filters))
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diagErr(err)
+	}
+
+	if err := d.Set("sku_options", skuOptions); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
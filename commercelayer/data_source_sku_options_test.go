@@ -0,0 +1,41 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceSkuOptionsRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourceSkuOptions(), map[string]interface{}{
+		"market_id": "market-nl",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/sku_options", r.URL.Path)
+		assert.Equal(t, "market-nl", r.URL.Query().Get("filter[q][market_id_eq]"))
+		assert.Equal(t, "name", r.URL.Query().Get("sort"))
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "sku-option-engraving",
+				"type": "sku_options",
+				"attributes": {"name": "Engraving", "currency_code": "EUR", "price_amount_cents": 500, "delay_hours": 48, "delay_days": 2, "sku_code_regex": "^TSHIRT"},
+				"relationships": {"market": {"data": {"id": "market-nl", "type": "markets"}}}
+			}, {
+				"id": "sku-option-wrapping",
+				"type": "sku_options",
+				"attributes": {"name": "Gift wrapping", "currency_code": "EUR", "price_amount_cents": 250},
+				"relationships": {"market": {"data": {"id": "market-nl", "type": "markets"}}}
+			}],
+			"meta": {"record_count": 2, "page_count": 1}
+		}`))
+	})
+
+	diags := dataSourceSkuOptionsReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 2, d.Get("sku_options.#"))
+	assert.Equal(t, 48, d.Get("sku_options.0.delay_hours"))
+	assert.Equal(t, "^TSHIRT", d.Get("sku_options.0.sku_code_regex"))
+	assert.Equal(t, 250, d.Get("sku_options.1.price_amount_cents"))
+}
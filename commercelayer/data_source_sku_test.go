@@ -0,0 +1,43 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceSku_basic() {
+	resourceName := "data.commercelayer_sku.incentro_sku"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSkuDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccShippingCategoryCreate(resourceName),
+					testAccSkuCreate(resourceName),
+					testAccDataSourceSku()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "commercelayer_sku.incentro_sku", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Incentro T-shirt M"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.foo", "bar"),
+					resource.TestCheckResourceAttrPair(resourceName, "shipping_category_id",
+						"commercelayer_shipping_category.incentro_shipping_category", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSku() string {
+	return `
+		data "commercelayer_sku" "incentro_sku" {
+		  code = commercelayer_sku.incentro_sku.attributes[0].code
+		}
+	`
+}
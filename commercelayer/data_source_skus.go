@@ -0,0 +1,129 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceSkus() *schema.Resource {
+	sku := skuDataSourceSchema()
+	sku["id"] = &schema.Schema{
+		Description: "The SKU unique identifier",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	sku["code"] = &schema.Schema{
+		Description: "The SKU code",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+
+	dataSource := &schema.Resource{
+		Description: "List the SKUs of the organization, optionally filtered. All the pages are fetched, so this can " +
+			"be used with for_each over a subset of the catalog.",
+		ReadContext: dataSourceSkusReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The identifier of the applied filters",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filter": queryFilterSchema(),
+			"code_prefix": {
+				Description: "Only list the SKUs whose code starts with this prefix",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"name_contains": {
+				Description: "Only list the SKUs whose name contains this string",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"shipping_category_id": {
+				Description: "Only list the SKUs of this shipping category",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"reference": {
+				Description: "Only list the SKUs with this reference",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"skus": {
+				Description: "The matching SKUs, sorted by code",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: sku,
+				},
+			},
+		},
+	}
+
+	for key, value := range queryPaginationSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourceSkusReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	filters := url.Values{}
+	if codePrefix, ok := d.GetOk("code_prefix"); ok {
+		filters.Set("filter[q][code_start]", codePrefix.(string))
+	}
+	if nameContains, ok := d.GetOk("name_contains"); ok {
+		filters.Set("filter[q][name_cont]", nameContains.(string))
+	}
+	if shippingCategoryId, ok := d.GetOk("shipping_category_id"); ok {
+		filters.Set("filter[q][shipping_category_id_eq]", shippingCategoryId.(string))
+	}
+	if reference, ok := d.GetOk("reference"); ok {
+		filters.Set("filter[q][reference_eq]", reference.(string))
+	}
+
+	if err := expandQueryFilters(d, filters); err != nil {
+		return diagErr(err)
+	}
+
+	query := url.Values{
+		"include": {skuIncludes},
+		"sort":    {"code"},
+	}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	resources, truncated, err := listDataSourceResources(ctx, c, d, "/skus", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	skus := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		sku, err := flattenSku(resource)
+		if err != nil {
+			return diagErr(err)
+		}
+		sku["id"] = resource.Id
+		skus = append(skus, sku)
+	}
+
+	d.SetId(queryId(filters))
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diagErr(err)
+	}
+
+	if err := d.Set("skus", skus); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
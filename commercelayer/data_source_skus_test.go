@@ -0,0 +1,39 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceSkusRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourceSkus(), map[string]interface{}{
+		"code_prefix":          "PREORDER-",
+		"shipping_category_id": "vLrWQSwJeA",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PREORDER-", r.URL.Query().Get("filter[q][code_start]"))
+		assert.Equal(t, "vLrWQSwJeA", r.URL.Query().Get("filter[q][shipping_category_id_eq]"))
+
+		page := r.URL.Query().Get("page[number]")
+		_, _ = fmt.Fprintf(w, `{
+			"data": [{
+				"id": "sku-%s",
+				"type": "skus",
+				"attributes": {"code": "PREORDER-%s", "weight": 250, "do_not_ship": true},
+				"relationships": {"shipping_category": {"data": {"id": "vLrWQSwJeA", "type": "shipping_categories"}}}
+			}],
+			"meta": {"record_count": 2, "page_count": 2}
+		}`, page, page)
+	})
+
+	diags := dataSourceSkusReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 2, d.Get("skus.#"))
+	assert.Equal(t, "PREORDER-2", d.Get("skus.1.code"))
+	assert.Equal(t, 250.0, d.Get("skus.1.weight"))
+	assert.Equal(t, true, d.Get("skus.1.do_not_ship"))
+	assert.Equal(t, "vLrWQSwJeA", d.Get("skus.1.shipping_category_id"))
+}
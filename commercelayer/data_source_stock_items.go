@@ -0,0 +1,167 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceStockItems() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "List the stock items, i.e. the quantities of SKUs in stock locations, filtered by SKU code " +
+			"and/or stock location. This is useful for capacity checks, e.g. before enabling a new market.",
+		ReadContext: dataSourceStockItemsReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The identifier of the applied filters",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filter": queryFilterSchema(),
+			"sku_code": {
+				Description:  "Only list the stock items of the SKU with this code",
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"sku_code", "stock_location_id"},
+			},
+			"stock_location_id": {
+				Description:  "Only list the stock items of this stock location",
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"sku_code", "stock_location_id"},
+			},
+			"total_quantity": {
+				Description: "The sum of the quantities of the matching stock items",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"stock_items": {
+				Description: "The matching stock items, sorted by SKU code",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The stock item unique identifier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"sku_code": {
+							Description: "The code of the associated SKU",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"quantity": {
+							Description: "The stock item quantity",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"sku_id": {
+							Description: "The associated SKU id",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"stock_location_id": {
+							Description: "The associated stock location id",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference": {
+							Description: "The external identifier of the stock item",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference_origin": {
+							Description: "The identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"metadata": {
+							Description: "The key-value pairs attached to the stock item",
+							Type:        schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for key, value := range queryPaginationSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourceStockItemsReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	filters := url.Values{}
+	if skuCode, ok := d.GetOk("sku_code"); ok {
+		filters.Set("filter[q][sku_code_eq]", skuCode.(string))
+	}
+	if stockLocationId, ok := d.GetOk("stock_location_id"); ok {
+		filters.Set("filter[q][stock_location_id_eq]", stockLocationId.(string))
+	}
+
+	if err := expandQueryFilters(d, filters); err != nil {
+		return diagErr(err)
+	}
+
+	query := url.Values{
+		"include": {"sku,stock_location"},
+		"sort":    {"sku_code"},
+	}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	resources, truncated, err := listDataSourceResources(ctx, c, d, "/stock_items", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	totalQuantity := 0
+	stockItems := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		var attributes commercelayer.GETStockItems200ResponseDataInnerAttributes
+		err := resource.decodeAttributes(&attributes)
+		if err != nil {
+			return diag.Errorf("unexpected stock item %s: %s", resource.Id, err)
+		}
+
+		totalQuantity += int(attributes.GetQuantity())
+		stockItems = append(stockItems, map[string]interface{}{
+			"id":                resource.Id,
+			"sku_code":          attributes.GetSkuCode(),
+			"quantity":          int(attributes.GetQuantity()),
+			"sku_id":            resource.relationship("sku").Id,
+			"stock_location_id": resource.relationship("stock_location").Id,
+			"reference":         attributes.GetReference(),
+			"reference_origin":  attributes.GetReferenceOrigin(),
+			"metadata":          stringMap(attributes.GetMetadata()),
+		})
+	}
+
+	d.SetId(queryId(filters))
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diagErr(err)
+	}
+
+	if err := d.Set("total_quantity", totalQuantity); err != nil {
+		return diagErr(err)
+	}
+	if err := d.Set("stock_items", stockItems); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceStockItemsRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourceStockItems(), map[string]interface{}{
+		"sku_code": "TSHIRT-M",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/stock_items", r.URL.Path)
+		assert.Equal(t, "TSHIRT-M", r.URL.Query().Get("filter[q][sku_code_eq]"))
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "stock-item-ams",
+				"type": "stock_items",
+				"attributes": {"sku_code": "TSHIRT-M", "quantity": 40},
+				"relationships": {"stock_location": {"data": {"id": "stock-location-ams", "type": "stock_locations"}}}
+			}, {
+				"id": "stock-item-rtm",
+				"type": "stock_items",
+				"attributes": {"sku_code": "TSHIRT-M", "quantity": 2},
+				"relationships": {"stock_location": {"data": {"id": "stock-location-rtm", "type": "stock_locations"}}}
+			}],
+			"meta": {"record_count": 2, "page_count": 1}
+		}`))
+	})
+
+	diags := dataSourceStockItemsReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 42, d.Get("total_quantity"))
+	assert.Equal(t, 2, d.Get("stock_items.#"))
+	assert.Equal(t, "stock-location-rtm", d.Get("stock_items.1.stock_location_id"))
+}
@@ -0,0 +1,144 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceStockLocation() *schema.Resource {
+	lookupKeys := []string{"id", "number", "name", "reference", "label_format"}
+
+	return &schema.Resource{
+		Description: "Look up an existing stock location by id, or by any combination of number, name, reference " +
+			"and label format. This is useful to reference warehouses created outside of terraform, e.g. by a WMS " +
+			"integration, from inventory models and shipping methods.",
+		ReadContext: dataSourceStockLocationReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:   "The stock location unique identifier",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"number", "name", "reference", "label_format"},
+				AtLeastOneOf:  lookupKeys,
+			},
+			"number": {
+				Description:  "The stock location number",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: lookupKeys,
+			},
+			"name": {
+				Description:  "The stock location internal name",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: lookupKeys,
+			},
+			"reference": {
+				Description:  "The external identifier of the stock location, typically its code in the WMS",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: lookupKeys,
+			},
+			"label_format": {
+				Description: "The shipping label format, one of 'PDF', 'ZPL', 'EPL2', or 'PNG'. Exactly one stock " +
+					"location must match the given number, name, reference and label format.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: lookupKeys,
+			},
+			"suppress_etd": {
+				Description: "Indicates if the electronic invoice creation is skipped for the shipments",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the stock location",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"address_id": {
+				Description: "The associated address id",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceStockLocationReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	query := url.Values{"include": {"address"}}
+
+	var stockLocation *jsonApiResource
+	if id, ok := d.GetOk("id"); ok {
+		resource, err := getResource(ctx, c, "/stock_locations/"+id.(string), query)
+		if err != nil {
+			return diagErr(err)
+		}
+		stockLocation = resource
+	} else {
+		if number, ok := d.GetOk("number"); ok {
+			query.Set("filter[q][number_eq]", strconv.Itoa(number.(int)))
+		}
+		if name, ok := d.GetOk("name"); ok {
+			query.Set("filter[q][name_eq]", name.(string))
+		}
+		if reference, ok := d.GetOk("reference"); ok {
+			query.Set("filter[q][reference_eq]", reference.(string))
+		}
+		if labelFormat, ok := d.GetOk("label_format"); ok {
+			query.Set("filter[q][label_format_eq]", labelFormat.(string))
+		}
+
+		resource, err := findResource(ctx, c, "/stock_locations", query)
+		if err != nil {
+			return diagErr(err)
+		}
+		stockLocation = resource
+	}
+
+	var attributes commercelayer.GETStockLocations200ResponseDataInnerAttributes
+	err := stockLocation.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected stock location %s: %s", stockLocation.Id, err)
+	}
+
+	d.SetId(stockLocation.Id)
+
+	values := map[string]interface{}{
+		"number":           int(attributes.GetNumber()),
+		"name":             attributes.GetName(),
+		"reference":        attributes.GetReference(),
+		"label_format":     attributes.GetLabelFormat(),
+		"suppress_etd":     attributes.GetSuppressEtd(),
+		"reference_origin": attributes.GetReferenceOrigin(),
+		"metadata":         stringMap(attributes.GetMetadata()),
+		"address_id":       stockLocation.relationship("address").Id,
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
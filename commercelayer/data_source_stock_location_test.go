@@ -0,0 +1,45 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceStockLocation_basic() {
+	resourceName := "data.commercelayer_stock_location.incentro_stock_location"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckStockLocationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccAddressCreate(resourceName),
+					testAccStockLocationCreate(resourceName),
+					testAccDataSourceStockLocation()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"commercelayer_stock_location.incentro_stock_location", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "address_id",
+						"commercelayer_address.incentro_address", "id"),
+					resource.TestCheckResourceAttr(resourceName, "label_format", "PNG"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceStockLocation() string {
+	return `
+		data "commercelayer_stock_location" "incentro_stock_location" {
+		  name         = commercelayer_stock_location.incentro_stock_location.attributes[0].name
+		  label_format = "PNG"
+		}
+	`
+}
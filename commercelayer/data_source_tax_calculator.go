@@ -0,0 +1,103 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceTaxCalculator() *schema.Resource {
+	return &schema.Resource{
+		Description: "Look up an existing tax calculator of any kind (avalara, external, manual or taxjar) by id or " +
+			"name. The calculator type is returned along with its id, so the calculator can be attached to markets " +
+			"without knowing its kind upfront.",
+		ReadContext: dataSourceTaxCalculatorReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:  "The tax calculator unique identifier",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Description:  "The tax calculator name, which must match exactly one tax calculator",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"type": {
+				Description: "The tax calculator type, e.g. avalara_accounts or manual_tax_calculators",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference": {
+				Description: "The external identifier of the tax calculator",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the tax calculator",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTaxCalculatorReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	var taxCalculator *jsonApiResource
+	if id, ok := d.GetOk("id"); ok {
+		resource, err := getResource(ctx, c, "/tax_calculators/"+id.(string), nil)
+		if err != nil {
+			return diagErr(err)
+		}
+		taxCalculator = resource
+	} else {
+		resource, err := findResource(ctx, c, "/tax_calculators", url.Values{
+			"filter[q][name_eq]": {d.Get("name").(string)},
+		})
+		if err != nil {
+			return diagErr(err)
+		}
+		taxCalculator = resource
+	}
+
+	// the attributes common to all the tax calculators, the SDK uses the manual ones for the polymorphic endpoint
+	var attributes commercelayer.GETManualTaxCalculators200ResponseDataInnerAttributes
+	err := taxCalculator.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected tax calculator %s: %s", taxCalculator.Id, err)
+	}
+
+	d.SetId(taxCalculator.Id)
+
+	values := map[string]interface{}{
+		"name":             attributes.GetName(),
+		"type":             taxCalculator.Type,
+		"reference":        attributes.GetReference(),
+		"reference_origin": attributes.GetReferenceOrigin(),
+		"metadata":         stringMap(attributes.GetMetadata()),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
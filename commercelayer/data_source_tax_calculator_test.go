@@ -0,0 +1,41 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceTaxCalculator_basic() {
+	resourceName := "data.commercelayer_tax_calculator.incentro_tax_calculator"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckManualTaxCalculatorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccManualTaxCalculatorCreate(resourceName),
+					testAccDataSourceTaxCalculator()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"commercelayer_manual_tax_calculator.incentro_manual_tax_calculator", "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", manualTaxCalculatorsType),
+					resource.TestCheckResourceAttr(resourceName, "metadata.foo", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTaxCalculator() string {
+	return `
+		data "commercelayer_tax_calculator" "incentro_tax_calculator" {
+		  name = commercelayer_manual_tax_calculator.incentro_manual_tax_calculator.attributes[0].name
+		}
+	`
+}
@@ -0,0 +1,162 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceTaxCategories() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "List the tax categories, i.e. the tax codes assigned to SKUs for a tax calculator, optionally " +
+			"filtered by tax calculator and SKU code. This is useful to automate tax audits from terraform outputs.",
+		ReadContext: dataSourceTaxCategoriesReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The identifier of the applied filters",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filter": queryFilterSchema(),
+			"tax_calculator_id": {
+				Description: "Only list the tax categories of this tax calculator",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"sku_code": {
+				Description: "Only list the tax categories of the SKU with this code",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"tax_categories": {
+				Description: "The matching tax categories, sorted by SKU code",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The tax category unique identifier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"code": {
+							Description: "The tax category identifier code, specific for a particular tax calculator",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"sku_code": {
+							Description: "The code of the associated SKU",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"sku_id": {
+							Description: "The associated SKU id",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tax_calculator_id": {
+							Description: "The associated tax calculator id",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tax_calculator_type": {
+							Description: "The type of the associated tax calculator, e.g. 'avalara_accounts'",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference": {
+							Description: "The external identifier of the tax category",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reference_origin": {
+							Description: "The identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"metadata": {
+							Description: "The key-value pairs attached to the tax category",
+							Type:        schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for key, value := range queryPaginationSchema() {
+		dataSource.Schema[key] = value
+	}
+
+	return dataSource
+}
+
+func dataSourceTaxCategoriesReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	filters := url.Values{}
+	if taxCalculatorId, ok := d.GetOk("tax_calculator_id"); ok {
+		filters.Set("filter[q][tax_calculator_id_eq]", taxCalculatorId.(string))
+	}
+	if skuCode, ok := d.GetOk("sku_code"); ok {
+		filters.Set("filter[q][sku_code_eq]", skuCode.(string))
+	}
+
+	if err := expandQueryFilters(d, filters); err != nil {
+		return diagErr(err)
+	}
+
+	query := url.Values{
+		"include": {"sku,tax_calculator"},
+		"sort":    {"sku_code"},
+	}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	resources, truncated, err := listDataSourceResources(ctx, c, d, "/tax_categories", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	taxCategories := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		var attributes commercelayer.GETTaxCategories200ResponseDataInnerAttributes
+		err := resource.decodeAttributes(&attributes)
+		if err != nil {
+			return diag.Errorf("unexpected tax category %s: %s", resource.Id, err)
+		}
+
+		taxCalculator := resource.relationship("tax_calculator")
+		taxCategories = append(taxCategories, map[string]interface{}{
+			"id":                  resource.Id,
+			"code":                attributes.GetCode(),
+			"sku_code":            attributes.GetSkuCode(),
+			"sku_id":              resource.relationship("sku").Id,
+			"tax_calculator_id":   taxCalculator.Id,
+			"tax_calculator_type": taxCalculator.Type,
+			"reference":           attributes.GetReference(),
+			"reference_origin":    attributes.GetReferenceOrigin(),
+			"metadata":            stringMap(attributes.GetMetadata()),
+		})
+	}
+
+	d.SetId(queryId(filters))
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diagErr(err)
+	}
+
+	if err := d.Set("tax_categories", taxCategories); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
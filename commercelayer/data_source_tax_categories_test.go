@@ -0,0 +1,39 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceTaxCategoriesRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourceTaxCategories(), map[string]interface{}{
+		"tax_calculator_id": "avalara-eu",
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/tax_categories", r.URL.Path)
+		assert.Equal(t, "avalara-eu", r.URL.Query().Get("filter[q][tax_calculator_id_eq]"))
+		assert.Equal(t, "sku,tax_calculator", r.URL.Query().Get("include"))
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "tax-category-tshirt",
+				"type": "tax_categories",
+				"attributes": {"code": "PC040100", "sku_code": "TSHIRT-M"},
+				"relationships": {
+					"sku": {"data": {"id": "sku-tshirt", "type": "skus"}},
+					"tax_calculator": {"data": {"id": "avalara-eu", "type": "avalara_accounts"}}
+				}
+			}],
+			"meta": {"record_count": 1, "page_count": 1}
+		}`))
+	})
+
+	diags := dataSourceTaxCategoriesReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, d.Get("tax_categories.#"))
+	assert.Equal(t, "PC040100", d.Get("tax_categories.0.code"))
+	assert.Equal(t, "sku-tshirt", d.Get("tax_categories.0.sku_id"))
+	assert.Equal(t, "avalara_accounts", d.Get("tax_categories.0.tax_calculator_type"))
+}
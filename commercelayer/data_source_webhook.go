@@ -0,0 +1,153 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceWebhook() *schema.Resource {
+	lookupKeys := []string{"id", "name", "topic", "callback_url"}
+
+	return &schema.Resource{
+		Description: "Look up an existing webhook by id, or by any combination of name, topic and callback URL. " +
+			"This is useful for monitoring stacks to discover the shared secret and circuit state of webhooks " +
+			"without importing them.",
+		ReadContext: dataSourceWebhookReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description:   "The webhook unique identifier",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name", "topic", "callback_url"},
+				AtLeastOneOf:  lookupKeys,
+			},
+			"name": {
+				Description:  "The webhook's internal name",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: lookupKeys,
+			},
+			"topic": {
+				Description:  "The identifier of the resource/event that will trigger the webhook",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: lookupKeys,
+			},
+			"callback_url": {
+				Description: "The URL where the webhook payload is sent. Exactly one webhook must match the given " +
+					"name, topic and callback URL.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				AtLeastOneOf: lookupKeys,
+			},
+			"include_resources": {
+				Description: "The resources included in the webhook payload",
+				Type:        schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+			"shared_secret": {
+				Description: "The shared secret used to sign the external request payload",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"circuit_state": {
+				Description: "The circuit breaker state, either 'closed' or 'open'",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"circuit_failure_count": {
+				Description: "The number of consecutive failures recorded by the circuit breaker",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"reference": {
+				Description: "The external identifier of the webhook",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"reference_origin": {
+				Description: "The identifier of the third party system that defines the reference code",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The key-value pairs attached to the webhook",
+				Type:        schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceWebhookReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	var webhook *jsonApiResource
+	if id, ok := d.GetOk("id"); ok {
+		resource, err := getResource(ctx, c, "/webhooks/"+id.(string), nil)
+		if err != nil {
+			return diagErr(err)
+		}
+		webhook = resource
+	} else {
+		query := url.Values{}
+		if name, ok := d.GetOk("name"); ok {
+			query.Set("filter[q][name_eq]", name.(string))
+		}
+		if topic, ok := d.GetOk("topic"); ok {
+			query.Set("filter[q][topic_eq]", topic.(string))
+		}
+		if callbackUrl, ok := d.GetOk("callback_url"); ok {
+			query.Set("filter[q][callback_url_eq]", callbackUrl.(string))
+		}
+
+		resource, err := findResource(ctx, c, "/webhooks", query)
+		if err != nil {
+			return diagErr(err)
+		}
+		webhook = resource
+	}
+
+	var attributes commercelayer.GETWebhooks200ResponseDataInnerAttributes
+	err := webhook.decodeAttributes(&attributes)
+	if err != nil {
+		return diag.Errorf("unexpected webhook %s: %s", webhook.Id, err)
+	}
+
+	d.SetId(webhook.Id)
+
+	values := map[string]interface{}{
+		"name":                  attributes.GetName(),
+		"topic":                 attributes.GetTopic(),
+		"callback_url":          attributes.GetCallbackUrl(),
+		"include_resources":     attributes.GetIncludeResources(),
+		"shared_secret":         attributes.GetSharedSecret(),
+		"circuit_state":         attributes.GetCircuitState(),
+		"circuit_failure_count": int(attributes.GetCircuitFailureCount()),
+		"reference":             attributes.GetReference(),
+		"reference_origin":      attributes.GetReferenceOrigin(),
+		"metadata":              stringMap(attributes.GetMetadata()),
+	}
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
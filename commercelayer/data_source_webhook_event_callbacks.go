@@ -0,0 +1,168 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func dataSourceWebhookEventCallbacks() *schema.Resource {
+	dataSource := &schema.Resource{
+		Description: "List the most recent event callbacks of a webhook, i.e. its deliveries and their responses. " +
+			"This is useful for monitoring stacks to alert on delivery failures detected during refresh. Only the " +
+			"first page of callbacks is listed by default, raise max_pages or set it to 0 to list more.",
+		ReadContext: dataSourceWebhookEventCallbacksReadFunc,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The identifier of the applied filters",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"filter": queryFilterSchema(),
+			"webhook_id": {
+				Description: "The id of the webhook to list the event callbacks of",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"failures_count": {
+				Description: "The number of listed event callbacks that failed",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"event_callbacks": {
+				Description: "The most recent event callbacks, newest first",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The event callback unique identifier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"callback_url": {
+							Description: "The URI of the callback",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"response_code": {
+							Description: "The HTTP response code of the callback, empty if no response was received",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"response_message": {
+							Description: "The HTTP response message of the callback",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"failed": {
+							Description: "Indicates if the callback failed, i.e. did not get a 2xx response",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"payload_id": {
+							Description: "The id of the resource sent in the payload",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"payload_type": {
+							Description: "The type of the resource sent in the payload",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"created_at": {
+							Description: "Time at which the callback was sent",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for key, value := range queryPaginationSchema() {
+		dataSource.Schema[key] = value
+	}
+	// the callbacks of a webhook only grow, so only the most recent ones are listed unless asked otherwise
+	dataSource.Schema["max_pages"].Default = 1
+
+	return dataSource
+}
+
+func dataSourceWebhookEventCallbacksReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	filters := url.Values{
+		"filter[q][webhook_id_eq]": {d.Get("webhook_id").(string)},
+	}
+
+	if err := expandQueryFilters(d, filters); err != nil {
+		return diagErr(err)
+	}
+
+	query := url.Values{"sort": {"-created_at"}}
+	for key, values := range filters {
+		query[key] = values
+	}
+
+	// Event callbacks don't carry metadata, they are narrowed down by their webhook instead
+	resources, truncated, err := listDataSourceResources(unscopedContext(ctx), c, d, "/event_callbacks", query)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	failuresCount := 0
+	eventCallbacks := make([]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		var attributes commercelayer.GETEventCallbacks200ResponseDataInnerAttributes
+		err := resource.decodeAttributes(&attributes)
+		if err != nil {
+			return diag.Errorf("unexpected event callback %s: %s", resource.Id, err)
+		}
+
+		failed := !isSuccessResponseCode(attributes.GetResponseCode())
+		if failed {
+			failuresCount++
+		}
+
+		payload, _ := attributes.GetPayload()["data"].(map[string]interface{})
+		payloadId, _ := payload["id"].(string)
+		payloadType, _ := payload["type"].(string)
+
+		eventCallbacks = append(eventCallbacks, map[string]interface{}{
+			"id":               resource.Id,
+			"callback_url":     attributes.GetCallbackUrl(),
+			"response_code":    attributes.GetResponseCode(),
+			"response_message": attributes.GetResponseMessage(),
+			"failed":           failed,
+			"payload_id":       payloadId,
+			"payload_type":     payloadType,
+			"created_at":       attributes.GetCreatedAt(),
+		})
+	}
+
+	d.SetId(queryId(filters))
+
+	if err := d.Set("truncated", truncated); err != nil {
+		return diagErr(err)
+	}
+	if err := d.Set("failures_count", failuresCount); err != nil {
+		return diagErr(err)
+	}
+	if err := d.Set("event_callbacks", eventCallbacks); err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
+
+// isSuccessResponseCode returns whether an HTTP response code, as returned by the event callbacks, is a 2xx
+func isSuccessResponseCode(responseCode string) bool {
+	code, err := strconv.Atoi(responseCode)
+	return err == nil && code >= 200 && code < 300
+}
@@ -0,0 +1,53 @@
+package commercelayer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourceWebhookEventCallbacksRead(t *testing.T) {
+	c, d := testReadFixture(t, dataSourceWebhookEventCallbacks(), map[string]interface{}{
+		"webhook_id": "webhook-orders",
+		"page_size":  5,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/event_callbacks", r.URL.Path)
+		assert.Equal(t, "webhook-orders", r.URL.Query().Get("filter[q][webhook_id_eq]"))
+		assert.Equal(t, "-created_at", r.URL.Query().Get("sort"))
+		assert.Equal(t, "5", r.URL.Query().Get("page[size]"))
+
+		_, _ = w.Write([]byte(`{
+			"data": [{
+				"id": "callback-2",
+				"type": "event_callbacks",
+				"attributes": {
+					"callback_url": "https://example.com/hook",
+					"response_code": "502",
+					"response_message": "Bad Gateway",
+					"payload": {"data": {"id": "order-2", "type": "orders"}}
+				}
+			}, {
+				"id": "callback-1",
+				"type": "event_callbacks",
+				"attributes": {
+					"callback_url": "https://example.com/hook",
+					"response_code": "200",
+					"response_message": "OK",
+					"payload": {"data": {"id": "order-1", "type": "orders"}}
+				}
+			}],
+			"meta": {"record_count": 40, "page_count": 8}
+		}`))
+	})
+
+	diags := dataSourceWebhookEventCallbacksReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, 1, d.Get("failures_count"))
+	assert.Equal(t, 2, d.Get("event_callbacks.#"))
+	assert.Equal(t, true, d.Get("event_callbacks.0.failed"))
+	assert.Equal(t, "order-2", d.Get("event_callbacks.0.payload_id"))
+	assert.Equal(t, false, d.Get("event_callbacks.1.failed"))
+	assert.Equal(t, true, d.Get("truncated"))
+}
@@ -0,0 +1,44 @@
+package commercelayer
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func (s *AcceptanceSuite) TestAccDataSourceWebhook_basic() {
+	resourceName := "data.commercelayer_webhook.incentro_webhook"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccWebhookCreate(resourceName),
+					testAccDataSourceWebhook()}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id",
+						"commercelayer_webhook.incentro_webhook", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "shared_secret",
+						"commercelayer_webhook.incentro_webhook", "shared_secret"),
+					resource.TestCheckResourceAttr(resourceName, "circuit_state", "closed"),
+					resource.TestCheckResourceAttr(resourceName, "include_resources.0", "customer"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceWebhook() string {
+	return `
+		data "commercelayer_webhook" "incentro_webhook" {
+		  topic        = commercelayer_webhook.incentro_webhook.attributes[0].topic
+		  callback_url = commercelayer_webhook.incentro_webhook.attributes[0].callback_url
+		}
+	`
+}
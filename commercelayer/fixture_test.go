@@ -0,0 +1,43 @@
+package commercelayer
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/incentro-dc/go-commercelayer-sdk/api"
+	"golang.org/x/oauth2"
+)
+
+// testClient returns an API client for a test server answering its requests with handler. The server is closed when
+// the test ends.
+func testClient(t *testing.T, handler http.HandlerFunc) *api.APIClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return api.NewAPIClient(&api.Configuration{Servers: []api.ServerConfiguration{{URL: server.URL}}})
+}
+
+// testTokenClient is testClient for a client authenticated with an access token made of the claims
+func testTokenClient(t *testing.T, handler http.HandlerFunc, claims string) *api.APIClient {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	token := &oauth2.Token{
+		AccessToken: "header." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".signature",
+	}
+
+	return api.NewAPIClient(&api.Configuration{
+		Servers: []api.ServerConfiguration{{URL: server.URL}},
+		HTTPClient: &http.Client{
+			Transport: &oauth2.Transport{Source: oauth2.StaticTokenSource(token)},
+		},
+	})
+}
+
+// testReadFixture returns a testClient along with the resource data of r, set from raw, to test a read function
+func testReadFixture(t *testing.T, r *schema.Resource, raw map[string]interface{}, handler http.HandlerFunc) (*api.APIClient, *schema.ResourceData) {
+	return testClient(t, handler), schema.TestResourceDataRaw(t, r.Schema, raw)
+}
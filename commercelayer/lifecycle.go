@@ -0,0 +1,71 @@
+package commercelayer
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+// retainOnDestroySchema is the schema of the retain_on_destroy flag, used by resources that are typically shared
+// within an organization, like geocoders and payment gateways
+func retainOnDestroySchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "When true, destroying the resource only removes it from the terraform state and leaves it " +
+			"in Commercelayer, so it can not be removed by accident when it is shared with other configurations. " +
+			"Creating the resource then adopts an existing one of the same type and name, which is updated to the " +
+			"configuration, instead of creating a duplicate. Terraform destroys a resource the same way " +
+			"when it replaces it, e.g. with -replace or after a taint, so the replaced resource is left in " +
+			"Commercelayer as well.",
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+// retainableDeleteFunc wraps the delete function of a resource having the retain_on_destroy flag, so that it is only
+// called when the flag is not set. A delete doesn't tell a destroy from a replacement, so neither is deleted.
+func retainableDeleteFunc(deleteFunc schema.DeleteContextFunc) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+		if d.Get("retain_on_destroy").(bool) {
+			return nil
+		}
+		return deleteFunc(ctx, d, i)
+	}
+}
+
+// adoptableCreateFunc wraps the create function of a resource having the retain_on_destroy flag, so that a resource
+// of the same type and name that already exists is adopted when the flag is set. The adopted resource is updated to
+// the configuration, as it would be after an import.
+func adoptableCreateFunc(resourceType string, createFunc schema.CreateContextFunc, updateFunc schema.UpdateContextFunc) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+		if !d.Get("retain_on_destroy").(bool) {
+			return createFunc(ctx, d, i)
+		}
+
+		c := i.(*commercelayer.APIClient)
+		name := nestedMap(d.Get("attributes"))["name"].(string)
+
+		resources, err := listResources(ctx, c, "/"+resourceType, url.Values{
+			"filter[q][name_eq]": {name},
+		})
+		if err != nil {
+			return diagErr(err)
+		}
+
+		switch len(resources) {
+		case 0:
+			return createFunc(ctx, d, i)
+		case 1:
+			if err := d.Set("type", resourceType); err != nil {
+				return diagErr(err)
+			}
+			d.SetId(resources[0].Id)
+			return updateFunc(ctx, d, i)
+		default:
+			return diag.Errorf("can't adopt %s %q, %d of them have that name", resourceType, name, len(resources))
+		}
+	}
+}
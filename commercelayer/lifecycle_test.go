@@ -0,0 +1,96 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetainableDeleteFunc(t *testing.T) {
+	for _, retain := range []bool{true, false} {
+		deleted := false
+		deleteFunc := retainableDeleteFunc(func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+			deleted = true
+			return nil
+		})
+
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+			"retain_on_destroy": retainOnDestroySchema(),
+		}, map[string]interface{}{
+			"retain_on_destroy": retain,
+		})
+
+		diags := deleteFunc(context.Background(), d, nil)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, !retain, deleted)
+	}
+}
+
+func TestAdoptableCreateFunc(t *testing.T) {
+	tests := []struct {
+		name     string
+		retain   bool
+		existing []string
+		created  bool
+		adopted  string
+		err      bool
+	}{
+		{name: "not retained", retain: false, existing: []string{"dlQbPhNNop"}, created: true},
+		{name: "nothing to adopt", retain: true, created: true},
+		{name: "adopted", retain: true, existing: []string{"dlQbPhNNop"}, adopted: "dlQbPhNNop"},
+		{name: "ambiguous", retain: true, existing: []string{"dlQbPhNNop", "BjQxbhJdvW"}, err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			listed := false
+			c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+				listed = true
+				assert.Equal(t, "/google_geocoders", r.URL.Path)
+				assert.Equal(t, "Incentro Geocoder", r.URL.Query().Get("filter[q][name_eq]"))
+
+				var data []string
+				for _, id := range tt.existing {
+					data = append(data, fmt.Sprintf(`{"id": %q, "type": "google_geocoders"}`, id))
+				}
+
+				w.Header().Set("Content-Type", "application/vnd.api+json")
+				_, _ = fmt.Fprintf(w, `{"data": [%s], "meta": {"page_count": 1}}`, strings.Join(data, ","))
+			})
+
+			created, updated := false, ""
+			createFunc := adoptableCreateFunc(googleGeocodersType,
+				func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+					created = true
+					return nil
+				},
+				func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+					updated = d.Id()
+					return nil
+				})
+
+			d := schema.TestResourceDataRaw(t, resourceGoogleGeocoders().Schema, map[string]interface{}{
+				"retain_on_destroy": tt.retain,
+				"attributes": []interface{}{map[string]interface{}{
+					"name":    "Incentro Geocoder",
+					"api_key": "key",
+				}},
+			})
+
+			diags := createFunc(context.Background(), d, c)
+			assert.Equal(t, tt.err, diags.HasError())
+			assert.Equal(t, tt.retain, listed)
+			assert.Equal(t, tt.created, created)
+			assert.Equal(t, tt.adopted, updated)
+			if tt.adopted != "" {
+				assert.Equal(t, googleGeocodersType, d.Get("type"))
+			}
+		})
+	}
+}
@@ -2,12 +2,16 @@ package commercelayer
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
-	"github.com/incentro-dc/go-commercelayer-sdk/api"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"github.com/incentro-dc/terraform-provider-commercelayer/client"
 	"golang.org/x/oauth2"
-	"golang.org/x/oauth2/clientcredentials"
+	"net/url"
+	"sync"
 )
 
 var baseSchema = map[string]*schema.Schema{
@@ -37,41 +41,122 @@ var baseSchema = map[string]*schema.Schema{
 		DefaultFunc: schema.EnvDefaultFunc("COMMERCELAYER_AUTH_ENDPOINT", nil),
 		Description: "The Commercelayer auth endpoint",
 	},
+	"scope_filter": {
+		Description: "Narrows down the resources the data sources look up or list to the ones carrying a marker, so " +
+			"several teams can share one organization. Resources read by id are not affected.",
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"metadata": {
+					Description: "The metadata key-value pairs the resources must contain, translated to " +
+						"filter[q][metadata_jcont]",
+					Type:     schema.TypeMap,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	},
 }
 
 var baseResourceMap = map[string]*schema.Resource{
-	"commercelayer_address":                   resourceAddress(),
-	"commercelayer_merchant":                  resourceMerchant(),
-	"commercelayer_price_list":                resourcePriceList(),
-	"commercelayer_customer_group":            resourceCustomerGroup(),
-	"commercelayer_webhook":                   resourceWebhook(),
-	"commercelayer_external_gateway":          resourceExternalGateway(),
-	"commercelayer_external_tax_calculator":   resourceExternalTaxCalculator(),
-	"commercelayer_market":                    resourceMarket(),
-	"commercelayer_inventory_model":           resourceInventoryModel(),
-	"commercelayer_shipping_method":           resourceShippingMethod(),
-	"commercelayer_shipping_zone":             resourceShippingZone(),
-	"commercelayer_shipping_category":         resourceShippingCategory(),
-	"commercelayer_stock_location":            resourceStockLocation(),
-	"commercelayer_inventory_return_location": resourceInventoryReturnLocation(),
-	"commercelayer_inventory_stock_location":  resourceInventoryStockLocation(),
-	"commercelayer_delivery_lead_time":        resourceDeliveryLeadTime(),
-	"commercelayer_manual_gateway":            resourceManualGateway(),
-	"commercelayer_adyen_gateway":             resourceAdyenGateway(),
-	"commercelayer_paypal_gateway":            resourcePaypalGateway(),
-	"commercelayer_klarna_gateway":            resourceKlarnaGateway(),
-	"commercelayer_braintree_gateway":         resourceBraintreeGateway(),
-	"commercelayer_checkout_com_gateway":      resourceCheckoutComGateway(),
-	"commercelayer_google_geocoder":           resourceGoogleGeocoders(),
-	"commercelayer_bing_geocoder":             resourceBingGeocoders(),
-	"commercelayer_stripe_gateway":            resourceStripeGateway(),
-	"commercelayer_payment_method":            resourcePaymentMethod(),
-	"commercelayer_manual_tax_calculator":     resourceManualTaxCalculator(),
-	"commercelayer_taxjar_accounts":           resourceTaxjarAccount(),
+	"commercelayer_address":                      resourceAddress(),
+	"commercelayer_merchant":                     resourceMerchant(),
+	"commercelayer_price_list":                   resourcePriceList(),
+	"commercelayer_customer_group":               resourceCustomerGroup(),
+	"commercelayer_webhook":                      resourceWebhook(),
+	"commercelayer_external_gateway":             resourceExternalGateway(),
+	"commercelayer_external_tax_calculator":      resourceExternalTaxCalculator(),
+	"commercelayer_market":                       resourceMarket(),
+	"commercelayer_inventory_model":              resourceInventoryModel(),
+	"commercelayer_shipping_method":              resourceShippingMethod(),
+	"commercelayer_shipping_zone":                resourceShippingZone(),
+	"commercelayer_shipping_category":            resourceShippingCategory(),
+	"commercelayer_stock_location":               resourceStockLocation(),
+	"commercelayer_inventory_return_location":    resourceInventoryReturnLocation(),
+	"commercelayer_inventory_stock_location":     resourceInventoryStockLocation(),
+	"commercelayer_delivery_lead_time":           resourceDeliveryLeadTime(),
+	"commercelayer_manual_gateway":               resourceManualGateway(),
+	"commercelayer_adyen_gateway":                resourceAdyenGateway(),
+	"commercelayer_paypal_gateway":               resourcePaypalGateway(),
+	"commercelayer_klarna_gateway":               resourceKlarnaGateway(),
+	"commercelayer_braintree_gateway":            resourceBraintreeGateway(),
+	"commercelayer_checkout_com_gateway":         resourceCheckoutComGateway(),
+	"commercelayer_google_geocoder":              resourceGoogleGeocoders(),
+	"commercelayer_bing_geocoder":                resourceBingGeocoders(),
+	"commercelayer_stripe_gateway":               resourceStripeGateway(),
+	"commercelayer_payment_method":               resourcePaymentMethod(),
+	"commercelayer_manual_tax_calculator":        resourceManualTaxCalculator(),
+	"commercelayer_taxjar_accounts":              resourceTaxjarAccount(),
+	"commercelayer_billing_info_validation_rule": resourceBillingInfoValidationRule(),
+	"commercelayer_import":                       resourceImport(),
+	"commercelayer_export":                       resourceExport(),
+	"commercelayer_cleanup":                      resourceCleanup(),
+	"commercelayer_shipping_weight_tier":         resourceShippingWeightTier(),
+	"commercelayer_sku":                          resourceSku(),
+	"commercelayer_sku_option":                   resourceSkuOption(),
+	"commercelayer_order":                        resourceOrder(),
+	"commercelayer_in_stock_subscription":        resourceInStockSubscription(),
+}
+
+var baseDataSourceMap = map[string]*schema.Resource{
+	"commercelayer_market":                  dataSourceMarket(),
+	"commercelayer_markets":                 dataSourceMarkets(),
+	"commercelayer_sku":                     dataSourceSku(),
+	"commercelayer_skus":                    dataSourceSkus(),
+	"commercelayer_price_list":              dataSourcePriceList(),
+	"commercelayer_shipping_category":       dataSourceShippingCategory(),
+	"commercelayer_shipping_method":         dataSourceShippingMethod(),
+	"commercelayer_shipping_zone":           dataSourceShippingZone(),
+	"commercelayer_stock_location":          dataSourceStockLocation(),
+	"commercelayer_inventory_model":         dataSourceInventoryModel(),
+	"commercelayer_merchant":                dataSourceMerchant(),
+	"commercelayer_customer_group":          dataSourceCustomerGroup(),
+	"commercelayer_webhook":                 dataSourceWebhook(),
+	"commercelayer_payment_method":          dataSourcePaymentMethod(),
+	"commercelayer_payment_gateway":         dataSourcePaymentGateway(),
+	"commercelayer_tax_calculator":          dataSourceTaxCalculator(),
+	"commercelayer_address":                 dataSourceAddress(),
+	"commercelayer_sku_list":                dataSourceSkuList(),
+	"commercelayer_prices":                  dataSourcePrices(),
+	"commercelayer_promotions":              dataSourcePromotions(),
+	"commercelayer_coupons":                 dataSourceCoupons(),
+	"commercelayer_customer":                dataSourceCustomer(),
+	"commercelayer_organization":            dataSourceOrganization(),
+	"commercelayer_application":             dataSourceApplication(),
+	"commercelayer_delivery_lead_times":     dataSourceDeliveryLeadTimes(),
+	"commercelayer_stock_items":             dataSourceStockItems(),
+	"commercelayer_attachments":             dataSourceAttachments(),
+	"commercelayer_gift_cards":              dataSourceGiftCards(),
+	"commercelayer_bundles":                 dataSourceBundles(),
+	"commercelayer_webhook_event_callbacks": dataSourceWebhookEventCallbacks(),
+	"commercelayer_import":                  dataSourceImport(),
+	"commercelayer_export":                  dataSourceExport(),
+	"commercelayer_carrier_accounts":        dataSourceCarrierAccounts(),
+	"commercelayer_packages":                dataSourcePackages(),
+	"commercelayer_tax_categories":          dataSourceTaxCategories(),
+	"commercelayer_geocoder":                dataSourceGeocoder(),
+	"commercelayer_sku_options":             dataSourceSkuOptions(),
+	"commercelayer_price_tiers":             dataSourcePriceTiers(),
 }
 
 type Configuration struct {
 	tokenSource oauth2.TokenSource
+	version     string
+	// clientConfig is kept once the provider has been configured, for data sources that need a differently scoped
+	// client than the one passed as meta
+	clientConfig client.Config
+	// scopeFilter holds the query filters of the scope_filter option, added to the lists of the data sources
+	scopeFilter url.Values
+
+	// marketClients holds the clients with a market scoped access token, by market id, so that their token is
+	// reused by all the reads of the market
+	marketClients   map[string]*commercelayer.APIClient
+	marketClientsMu sync.Mutex
 }
 
 type ProviderOption func(configuration *Configuration)
@@ -82,51 +167,83 @@ func WithTokenSource(tokenSource oauth2.TokenSource) ProviderOption {
 	}
 }
 
+func WithVersion(version string) ProviderOption {
+	return func(c *Configuration) {
+		c.version = version
+	}
+}
+
 func Provider(opts ...ProviderOption) plugin.ProviderFunc {
-	c := Configuration{}
+	c := Configuration{
+		version: "dev",
+	}
 
 	for _, opt := range opts {
 		opt(&c)
 	}
 
 	return func() *schema.Provider {
+		dataSourcesMap := map[string]*schema.Resource{
+			"commercelayer_provider_info":    dataSourceProviderInfo(c.version),
+			"commercelayer_sku_availability": dataSourceSkuAvailability(&c),
+		}
+		for name, dataSource := range baseDataSourceMap {
+			scoped := *dataSource
+			scoped.ReadContext = scopedReadContext(&c, dataSource.ReadContext)
+			dataSourcesMap[name] = &scoped
+		}
+
 		return &schema.Provider{
 			Schema:               baseSchema,
 			ResourcesMap:         baseResourceMap,
+			DataSourcesMap:       dataSourcesMap,
 			ConfigureContextFunc: c.configureFunc,
 		}
 	}
 }
 
 func (c *Configuration) configureFunc(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	clientId := d.Get("client_id").(string)
-	clientSecret := d.Get("client_secret").(string)
-	apiEndpoint := d.Get("api_endpoint").(string)
-	authEndpoint := d.Get("auth_endpoint").(string)
-
-	credentials := clientcredentials.Config{
-		ClientID:     clientId,
-		ClientSecret: clientSecret,
-		TokenURL:     authEndpoint,
-		Scopes:       []string{},
+	c.clientConfig = client.Config{
+		ClientId:     d.Get("client_id").(string),
+		ClientSecret: d.Get("client_secret").(string),
+		ApiEndpoint:  d.Get("api_endpoint").(string),
+		AuthEndpoint: d.Get("auth_endpoint").(string),
+		TokenSource:  c.tokenSource,
+		Debug:        logging.IsDebugOrHigher(),
 	}
 
-	newCtx := context.Background()
+	scopeFilter, err := expandScopeFilter(nestedMap(d.Get("scope_filter")))
+	if err != nil {
+		return nil, diagErr(err)
+	}
+	c.scopeFilter = scopeFilter
 
-	var tokenSource = credentials.TokenSource(newCtx)
-	if c.tokenSource != nil {
-		tokenSource = c.tokenSource
+	c.marketClientsMu.Lock()
+	c.marketClients = nil
+	c.marketClientsMu.Unlock()
+
+	return client.New(c.clientConfig), nil
+}
+
+// marketClient returns a client whose access token is scoped to a market, which Commercelayer requires to compute
+// market specific values like the inventory of a SKU. The token is always requested through the client
+// credentials flow, as a token source given to the provider can't be scoped.
+func (c *Configuration) marketClient(marketId string) *commercelayer.APIClient {
+	c.marketClientsMu.Lock()
+	defer c.marketClientsMu.Unlock()
+
+	if marketClient, ok := c.marketClients[marketId]; ok {
+		return marketClient
 	}
 
-	httpClient := oauth2.NewClient(newCtx, tokenSource)
+	cfg := c.clientConfig
+	cfg.Scopes = []string{fmt.Sprintf("market:id:%s", marketId)}
+	cfg.TokenSource = nil
 
-	commercelayerClient := api.NewAPIClient(&api.Configuration{
-		HTTPClient: httpClient,
-		Debug:      true,
-		Servers: []api.ServerConfiguration{
-			{URL: apiEndpoint},
-		},
-	})
+	if c.marketClients == nil {
+		c.marketClients = map[string]*commercelayer.APIClient{}
+	}
+	c.marketClients[marketId] = client.New(cfg)
 
-	return commercelayerClient, nil
+	return c.marketClients[marketId]
 }
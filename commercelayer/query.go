@@ -0,0 +1,397 @@
+package commercelayer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+// queryPageSize is the maximum page size allowed by the Commercelayer API
+const queryPageSize = 25
+
+// jsonApiResource is a resource object as returned by the Commercelayer API. It is used by the data sources, which
+// need the filters, includes and pagination query parameters that the SDK does not support.
+type jsonApiResource struct {
+	Id            string                         `json:"id"`
+	Type          string                         `json:"type"`
+	Attributes    json.RawMessage                `json:"attributes"`
+	Relationships map[string]jsonApiRelationship `json:"relationships"`
+}
+
+type jsonApiRelationship struct {
+	Data json.RawMessage `json:"data"`
+}
+
+type jsonApiIdentifier struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// decodeAttributes decodes the attributes of the resource into v, typically the attributes model of the SDK
+func (r jsonApiResource) decodeAttributes(v interface{}) error {
+	if len(r.Attributes) == 0 {
+		return nil
+	}
+	return json.Unmarshal(r.Attributes, v)
+}
+
+// relationship returns the identifier of a to-one relationship, which is only set when the relationship has been
+// included in the request
+func (r jsonApiResource) relationship(name string) jsonApiIdentifier {
+	var identifier jsonApiIdentifier
+	if relationship, ok := r.Relationships[name]; ok && len(relationship.Data) > 0 {
+		_ = json.Unmarshal(relationship.Data, &identifier)
+	}
+	return identifier
+}
+
+// getResource retrieves a single resource, e.g. /markets/xYZkjABcde
+func getResource(ctx context.Context, c *commercelayer.APIClient, path string, query url.Values) (*jsonApiResource, error) {
+	var document struct {
+		Data *jsonApiResource `json:"data"`
+	}
+
+	err := queryDocument(ctx, c, path, query, &document)
+	if err != nil {
+		return nil, err
+	}
+	if document.Data == nil {
+		return nil, fmt.Errorf("%s not found", path)
+	}
+
+	return document.Data, nil
+}
+
+// listResources retrieves all the resources of a collection matching the query, e.g. /markets with
+// filter[q][name_eq], walking through all the pages
+func listResources(ctx context.Context, c *commercelayer.APIClient, path string, query url.Values) ([]jsonApiResource, error) {
+	resources, _, err := listResourcePages(ctx, c, path, query, queryPageSize, 0)
+	return resources, err
+}
+
+// listResourcePages retrieves the resources of a collection matching the query, walking through at most maxPages
+// pages of pageSize resources, or all of them when maxPages is 0. It also returns whether more pages were left.
+func listResourcePages(ctx context.Context, c *commercelayer.APIClient, path string, query url.Values, pageSize int, maxPages int) ([]jsonApiResource, bool, error) {
+	var resources []jsonApiResource
+
+	pageQuery := url.Values{}
+	for key, values := range query {
+		pageQuery[key] = values
+	}
+	pageQuery.Set("page[size]", strconv.Itoa(pageSize))
+	if err := scopeQuery(ctx, pageQuery); err != nil {
+		return nil, false, err
+	}
+
+	for page := 1; ; page++ {
+		var document struct {
+			Data []jsonApiResource `json:"data"`
+			Meta struct {
+				PageCount int `json:"page_count"`
+			} `json:"meta"`
+		}
+
+		pageQuery.Set("page[number]", strconv.Itoa(page))
+		err := queryDocument(ctx, c, path, pageQuery, &document)
+		if err != nil {
+			return nil, false, err
+		}
+
+		resources = append(resources, document.Data...)
+
+		if page >= document.Meta.PageCount {
+			return resources, false, nil
+		}
+		if maxPages > 0 && page >= maxPages {
+			return resources, true, nil
+		}
+	}
+}
+
+// listDataSourceResources retrieves the resources of a data source listing resources, honouring its page_size and
+// max_pages arguments
+func listDataSourceResources(ctx context.Context, c *commercelayer.APIClient, d *schema.ResourceData, path string, query url.Values) ([]jsonApiResource, bool, error) {
+	return listResourcePages(ctx, c, path, query, d.Get("page_size").(int), d.Get("max_pages").(int))
+}
+
+// firstResources retrieves the first resources of a collection matching the query, up to limit, which can't exceed
+// the queryPageSize. It is meant for collections that only grow, e.g. the most recent event callbacks.
+func firstResources(ctx context.Context, c *commercelayer.APIClient, path string, query url.Values, limit int) ([]jsonApiResource, error) {
+	if limit > queryPageSize {
+		return nil, fmt.Errorf("can't retrieve more than %d of %s at once", queryPageSize, path)
+	}
+
+	var document struct {
+		Data []jsonApiResource `json:"data"`
+	}
+
+	pageQuery := url.Values{}
+	for key, values := range query {
+		pageQuery[key] = values
+	}
+	pageQuery.Set("page[size]", strconv.Itoa(limit))
+	pageQuery.Set("page[number]", "1")
+	if err := scopeQuery(ctx, pageQuery); err != nil {
+		return nil, err
+	}
+
+	err := queryDocument(ctx, c, path, pageQuery, &document)
+	if err != nil {
+		return nil, err
+	}
+
+	return document.Data, nil
+}
+
+// findResource retrieves the single resource of a collection matching the query, e.g. the market with a given name.
+// It is an error when no or several resources match.
+func findResource(ctx context.Context, c *commercelayer.APIClient, path string, query url.Values) (*jsonApiResource, error) {
+	resources, err := listResources(ctx, c, path, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resources) != 1 {
+		return nil, fmt.Errorf("expected exactly one of %s to match, found %d", path, len(resources))
+	}
+
+	return &resources[0], nil
+}
+
+// queryFilterPredicates are the predicates of the filter expressions supported by the Commercelayer API
+var queryFilterPredicates = []string{
+	"eq", "not_eq", "eq_any", "matches", "does_not_match", "matches_any",
+	"lt", "lteq", "gt", "gteq",
+	"in", "not_in",
+	"cont", "not_cont", "cont_any", "cont_all", "i_cont", "i_cont_any",
+	"start", "not_start", "start_any", "end", "not_end", "end_any",
+	"true", "false", "present", "blank", "null", "not_null",
+}
+
+// queryFilterListPredicates are the predicates that accept a comma separated list of values
+var queryFilterListPredicates = []string{
+	"eq_any", "matches_any", "in", "not_in", "cont_any", "cont_all", "i_cont_any", "start_any", "end_any",
+}
+
+// queryFilterSchema returns the filter blocks shared by the data sources listing resources, which are translated to
+// filter[q][attribute_predicate] query parameters on top of the dedicated arguments of each data source
+func queryFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Additional filter expressions, e.g. attribute 'created_at', predicate 'gteq' and values " +
+			"['2023-01-01T00:00:00Z'] for filter[q][created_at_gteq]. All the filters must match.",
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"attribute": {
+					Description: "The attribute to filter on, possibly through a relationship, e.g. 'name' or " +
+						"'market_name'",
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(
+						regexp.MustCompile(`^[a-z][a-z0-9_]*$`), "must be a snake cased attribute name")),
+				},
+				"predicate": {
+					Description: fmt.Sprintf("The predicate of the filter, one of '%s'",
+						strings.Join(queryFilterPredicates, "', '")),
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateDiagFunc: validation.ToDiagFunc(
+						validation.StringInSlice(queryFilterPredicates, false)),
+				},
+				"values": {
+					Description: fmt.Sprintf("The value of the filter. Only the '%s' predicates accept several values.",
+						strings.Join(queryFilterListPredicates, "', '")),
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandQueryFilters adds the filter blocks of a data source to its filters. A filter block can't override the
+// filter of a dedicated argument.
+func expandQueryFilters(d *schema.ResourceData, filters url.Values) error {
+	for _, f := range d.Get("filter").([]interface{}) {
+		filter := f.(map[string]interface{})
+		predicate := filter["predicate"].(string)
+
+		var values []string
+		for _, value := range filter["values"].([]interface{}) {
+			// empty strings are read as nil from the list
+			v, _ := value.(string)
+			values = append(values, v)
+		}
+
+		if len(values) > 1 && !queryFilterListPredicate(predicate) {
+			return fmt.Errorf("the %s predicate accepts a single value, got %d", predicate, len(values))
+		}
+
+		key := fmt.Sprintf("filter[q][%s_%s]", filter["attribute"].(string), predicate)
+		if filters.Has(key) {
+			return fmt.Errorf("%s is set more than once", key)
+		}
+		filters.Set(key, strings.Join(values, ","))
+	}
+
+	return nil
+}
+
+func queryFilterListPredicate(predicate string) bool {
+	for _, p := range queryFilterListPredicates {
+		if p == predicate {
+			return true
+		}
+	}
+	return false
+}
+
+// queryScopeKey is the context key of the scope filters added to the queries of a data source, see scopedReadContext
+type queryScopeKey struct{}
+
+// scopedReadContext wraps the read function of a data source, so the resources it looks up or lists are narrowed down
+// to the scope_filter of the provider
+func scopedReadContext(c *Configuration, read schema.ReadContextFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+		return read(context.WithValue(ctx, queryScopeKey{}, c.scopeFilter), d, i)
+	}
+}
+
+// unscopedContext drops the scope filters from the context of a data source listing resources without metadata
+func unscopedContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, queryScopeKey{}, url.Values(nil))
+}
+
+// expandScopeFilter translates the scope_filter of the provider to query filters
+func expandScopeFilter(scopeFilter map[string]interface{}) (url.Values, error) {
+	metadata, _ := scopeFilter["metadata"].(map[string]interface{})
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+
+	value, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return url.Values{"filter[q][metadata_jcont]": {string(value)}}, nil
+}
+
+// scopeQuery adds the scope filters carried by the context of a data source to its query. They can't be overridden.
+func scopeQuery(ctx context.Context, query url.Values) error {
+	scope, _ := ctx.Value(queryScopeKey{}).(url.Values)
+	for key, values := range scope {
+		if query.Has(key) {
+			return fmt.Errorf("%s is already set by the scope_filter of the provider", key)
+		}
+		query[key] = values
+	}
+	return nil
+}
+
+// queryPaginationSchema returns the pagination arguments and the truncated attribute shared by the data sources
+// listing resources
+func queryPaginationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"page_size": {
+			Description:      fmt.Sprintf("The number of resources fetched per request, at most %d", queryPageSize),
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          queryPageSize,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, queryPageSize)),
+		},
+		"max_pages": {
+			Description: "The maximum number of pages to fetch, all the pages are fetched when 0. When there are " +
+				"more pages, truncated is set.",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          0,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		},
+		"truncated": {
+			Description: "Indicates if the results are incomplete, because max_pages was reached",
+			Type:        schema.TypeBool,
+			Computed:    true,
+		},
+	}
+}
+
+// queryId returns a stable identifier for the data sources listing resources, derived from their filters
+func queryId(filters url.Values) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(filters.Encode())))
+}
+
+// queryError is the error of a failed query. Like the errors of the SDK it carries the body of the response, so that
+// diagErr reports both the same way, and its status code is checked by apiErrorStatus.
+type queryError struct {
+	path       string
+	status     string
+	statusCode int
+	body       []byte
+}
+
+func (e *queryError) Error() string {
+	return fmt.Sprintf("GET %s: %s", e.path, e.status)
+}
+
+func (e *queryError) Body() []byte {
+	return e.body
+}
+
+// queryDocument retrieves a JSON:API document. The requests of the SDK don't take any query parameters, so the
+// filters, includes, sorting and pagination the data sources need can't go through them. The request is still sent
+// with the HTTP client, endpoint and user agent of the SDK configuration, so it is authenticated the same way.
+func queryDocument(ctx context.Context, c *commercelayer.APIClient, path string, query url.Values, document interface{}) error {
+	cfg := c.GetConfig()
+
+	endpoint := cfg.Servers[0].URL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.api+json")
+	req.Header.Set("User-Agent", cfg.UserAgent)
+
+	resp, err := cfg.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= 300 {
+		return &queryError{
+			path:       path,
+			status:     resp.Status,
+			statusCode: resp.StatusCode,
+			body:       body,
+		}
+	}
+
+	return json.Unmarshal(body, document)
+}
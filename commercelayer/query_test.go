@@ -0,0 +1,170 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListResourcesWalksAllPages(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/markets", r.URL.Path)
+		assert.Equal(t, "Europe", r.URL.Query().Get("filter[q][name_eq]"))
+		assert.Equal(t, "25", r.URL.Query().Get("page[size]"))
+
+		page := r.URL.Query().Get("page[number]")
+		_, _ = fmt.Fprintf(w, `{
+			"data": [{
+				"id": "market-%s",
+				"type": "markets",
+				"attributes": {"name": "Europe", "number": %s},
+				"relationships": {
+					"merchant": {"data": {"id": "merchant-%s", "type": "merchants"}},
+					"tax_calculator": {"data": null}
+				}
+			}],
+			"meta": {"record_count": 2, "page_count": 2}
+		}`, page, page, page)
+	})
+	markets, err := listResources(context.Background(), c, "/markets", url.Values{
+		"filter[q][name_eq]": {"Europe"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, markets, 2)
+	assert.Equal(t, "market-2", markets[1].Id)
+	assert.Equal(t, "merchant-2", markets[1].relationship("merchant").Id)
+	assert.Equal(t, "", markets[1].relationship("tax_calculator").Id)
+	assert.Equal(t, "", markets[1].relationship("price_list").Id)
+
+	values, err := flattenMarket(markets[1])
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe", values["name"])
+	assert.Equal(t, 2, values["number"])
+	assert.Equal(t, "merchant-2", values["merchant_id"])
+}
+
+func TestListResourcePagesTruncates(t *testing.T) {
+	var requests int
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "10", r.URL.Query().Get("page[size]"))
+
+		_, _ = fmt.Fprintf(w, `{
+			"data": [{"id": "sku-%s", "type": "skus"}],
+			"meta": {"record_count": 50, "page_count": 5}
+		}`, r.URL.Query().Get("page[number]"))
+	})
+	skus, truncated, err := listResourcePages(context.Background(), c, "/skus", nil, 10, 2)
+	assert.NoError(t, err)
+	assert.True(t, truncated)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, "sku-2", skus[1].Id)
+
+	requests = 0
+	skus, truncated, err = listResourcePages(context.Background(), c, "/skus", nil, 10, 0)
+	assert.NoError(t, err)
+	assert.False(t, truncated)
+	assert.Equal(t, 5, requests)
+	assert.Len(t, skus, 5)
+}
+
+func TestGetResourceError(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[{"title":"Not found"}]}`))
+	})
+	_, err := getResource(context.Background(), c, "/markets/foo", nil)
+	assert.ErrorContains(t, err, "404 Not Found")
+}
+
+func TestExpandQueryFilters(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceSkus().Schema, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{"attribute": "created_at", "predicate": "gteq", "values": []interface{}{"2023-01-01T00:00:00Z"}},
+			map[string]interface{}{"attribute": "code", "predicate": "in", "values": []interface{}{"TSHIRT-S", "TSHIRT-M"}},
+		},
+	})
+
+	filters := url.Values{"filter[q][code_start]": {"TSHIRT"}}
+	err := expandQueryFilters(d, filters)
+	assert.NoError(t, err)
+	assert.Equal(t, "2023-01-01T00:00:00Z", filters.Get("filter[q][created_at_gteq]"))
+	assert.Equal(t, "TSHIRT-S,TSHIRT-M", filters.Get("filter[q][code_in]"))
+	assert.Equal(t, "TSHIRT", filters.Get("filter[q][code_start]"))
+}
+
+func TestExpandQueryFiltersErrors(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataSourceSkus().Schema, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{"attribute": "code", "predicate": "eq", "values": []interface{}{"TSHIRT-S", "TSHIRT-M"}},
+		},
+	})
+	assert.EqualError(t, expandQueryFilters(d, url.Values{}), "the eq predicate accepts a single value, got 2")
+
+	d = schema.TestResourceDataRaw(t, dataSourceSkus().Schema, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{"attribute": "code", "predicate": "start", "values": []interface{}{"TSHIRT"}},
+		},
+	})
+	filters := url.Values{"filter[q][code_start]": {"JEANS"}}
+	assert.EqualError(t, expandQueryFilters(d, filters), "filter[q][code_start] is set more than once")
+}
+
+func TestQueryDocumentError(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = fmt.Fprint(w, `{"errors":[{"title":"You are not authorized to perform this action."}]}`)
+	})
+	_, err := listResources(context.Background(), c, "/orders", nil)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, apiErrorStatus(err))
+
+	diags := diagErr(err)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, `GET /orders: 403 Forbidden: {"errors":[{"title":"You are not authorized to perform this action."}]}`,
+		diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "not permitted")
+}
+
+func TestScopedReadContext(t *testing.T) {
+	scopes := map[string]string{}
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		scopes[r.URL.Path] = r.URL.Query().Get("filter[q][metadata_jcont]")
+		_, _ = fmt.Fprint(w, `{"data": [], "meta": {"record_count": 0, "page_count": 1}}`)
+	})
+
+	scopeFilter, err := expandScopeFilter(map[string]interface{}{
+		"metadata": map[string]interface{}{"team": "checkout"},
+	})
+	assert.NoError(t, err)
+	cfg := &Configuration{scopeFilter: scopeFilter}
+
+	read := scopedReadContext(cfg, dataSourceMarkets().ReadContext)
+	diags := read(context.Background(), schema.TestResourceDataRaw(t, dataSourceMarkets().Schema, map[string]interface{}{}), c)
+	assert.False(t, diags.HasError())
+
+	read = scopedReadContext(cfg, dataSourceWebhookEventCallbacks().ReadContext)
+	diags = read(context.Background(), schema.TestResourceDataRaw(t, dataSourceWebhookEventCallbacks().Schema, map[string]interface{}{
+		"webhook_id": "webhook",
+	}), c)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, map[string]string{
+		"/markets":         `{"team":"checkout"}`,
+		"/event_callbacks": "",
+	}, scopes)
+}
+
+func TestScopeQueryConflict(t *testing.T) {
+	ctx := context.WithValue(context.Background(), queryScopeKey{}, url.Values{
+		"filter[q][metadata_jcont]": {`{"team":"checkout"}`},
+	})
+
+	err := scopeQuery(ctx, url.Values{"filter[q][metadata_jcont]": {`{"team":"billing"}`}})
+	assert.EqualError(t, err, "filter[q][metadata_jcont] is already set by the scope_filter of the provider")
+}
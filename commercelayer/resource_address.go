@@ -2,9 +2,11 @@ package commercelayer
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"strconv"
 )
 
 func resourceAddress() *schema.Resource {
@@ -37,117 +39,34 @@ func resourceAddress() *schema.Resource {
 				MinItems:    1,
 				Required:    true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"business": {
-							Description: "Indicates if it's a business or a personal address",
-							Type:        schema.TypeBool,
-							Optional:    true,
-							Default:     false,
-						},
-						"first_name": {
-							Description: "Address first name",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"last_name": {
-							Description: "Address last name",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"company": {
-							Description: "Address company name",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"line_1": {
-							Description: "Address line 1, i.e. Street address, PO Box",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-						"line_2": {
-							Description: "Address line 2, i.e. Apartment, Suite, Building",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"city": {
-							Description: "Address city",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-						"zip_code": {
-							Description: "ZIP or postal code",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"state_code": {
-							Description: "State, province or region code",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-						"country_code": {
-							Description: "The international 2-letter country code as defined by the ISO 3166-1 standard",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-						"phone": {
-							Description: "Phone number (including extension).",
-							Type:        schema.TypeString,
-							Required:    true,
-						},
-						"email": {
-							Description: "Email address",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"notes": {
-							Description: "A free notes attached to the address. When used as a shipping address, this " +
-								"can be useful to let the customers add specific delivery instructions.",
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"lat": {
-							Description: "The address geocoded latitude. This is automatically generated when " +
-								"creating a shipping/billing address for an order and a valid geocoder is attached to " +
-								"the order's market.",
-							Type:     schema.TypeFloat,
-							Optional: true,
-						},
-						"lng": {
-							Description: "The address geocoded longitude. This is automatically generated when " +
-								"creating a shipping/billing address for an order and a valid geocoder is attached " +
-								"to the order's market.",
-							Type:     schema.TypeFloat,
-							Optional: true,
-						},
-						"billing_info": {
-							Description: "Customer's billing information (i.e. VAT number, codice fiscale)",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"reference": {
-							Description: "A string that you can use to add any external identifier to the resource. This " +
-								"can be useful for integrating the resource to an external system, like an ERP, a " +
-								"marketing tool, a CRM, or whatever.",
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"reference_origin": {
-							Description: "Any identifier of the third party system that defines the reference code",
-							Type:        schema.TypeString,
-							Optional:    true,
-						},
-						"metadata": {
-							Description: "Set of key-value pairs that you can attach to the resource. This can be useful " +
-								"for storing additional information about the resource in a structured format",
-							Type: schema.TypeMap,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-							Optional: true,
-						},
-					},
+					Schema: addressAttributesSchema(),
 				},
 			},
+			"is_geocoded": {
+				Description: "Indicates if the address has been successfully geocoded.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"geocoded_lat": {
+				Description: "The latitude returned by the geocoder of the address.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"geocoded_lng": {
+				Description: "The longitude returned by the geocoder of the address.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"full_address": {
+				Description: "The compact description of the address, as normalized by Commercelayer.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"provider_name": {
+				Description: "The name of the geocoder provider, e.g. google or bing.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"relationships": {
 				Description: "Resource relationships",
 				Type:        schema.TypeList,
@@ -157,9 +76,10 @@ func resourceAddress() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"geocoder_id": {
-							Description: "The associated geocoder id.",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Description: "The associated geocoder id, e.g. the id of a commercelayer_google_geocoder " +
+								"or commercelayer_bing_geocoder managed in the same configuration.",
+							Type:     schema.TypeString,
+							Optional: true,
 						},
 					},
 				},
@@ -173,7 +93,7 @@ func resourceAddressReadFunc(ctx context.Context, d *schema.ResourceData, i inte
 
 	resp, _, err := c.AddressesApi.GETAddressesAddressId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	address, ok := resp.GetDataOk()
@@ -184,7 +104,7 @@ func resourceAddressReadFunc(ctx context.Context, d *schema.ResourceData, i inte
 
 	d.SetId(address.GetId())
 
-	return nil
+	return diagErr(setAddressGeocoding(d, address.GetAttributes()))
 }
 
 func resourceAddressCreateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -195,38 +115,20 @@ func resourceAddressCreateFunc(ctx context.Context, d *schema.ResourceData, i in
 
 	addressCreate := commercelayer.AddressCreate{
 		Data: commercelayer.AddressCreateData{
-			Type: addressType,
-			Attributes: commercelayer.POSTAddresses201ResponseDataAttributes{
-				Business:        boolRef(attributes["business"]),
-				FirstName:       stringRef(attributes["first_name"]),
-				LastName:        stringRef(attributes["last_name"]),
-				Company:         stringRef(attributes["company"]),
-				Line1:           attributes["line_1"].(string),
-				Line2:           stringRef(attributes["line_2"]),
-				City:            attributes["city"].(string),
-				ZipCode:         stringRef(attributes["zip_code"]),
-				StateCode:       attributes["state_code"].(string),
-				CountryCode:     attributes["country_code"].(string),
-				Phone:           attributes["phone"].(string),
-				Email:           stringRef(attributes["email"]),
-				Notes:           stringRef(attributes["notes"]),
-				Lat:             float64ToFloat32Ref(attributes["lat"]),
-				Lng:             float64ToFloat32Ref(attributes["lng"]),
-				BillingInfo:     stringRef(attributes["billing_info"]),
-				Reference:       stringRef(attributes["reference"]),
-				ReferenceOrigin: stringRef(attributes["reference_origin"]),
-				Metadata:        keyValueRef(attributes["metadata"]),
-			},
+			Type:       addressType,
+			Attributes: addressCreateAttributes(attributes),
 		},
 	}
 
 	geocoderId := stringRef(relationships["geocoder_id"])
 	if geocoderId != nil {
-		addressCreate.Data.Relationships.Geocoder = &commercelayer.AddressCreateDataRelationshipsGeocoder{
-			Data: commercelayer.AddressDataRelationshipsGeocoderData{
-				Type: stringRef(geocoderType),
-				Id:   stringRef(geocoderId),
-			}}
+		addressCreate.Data.Relationships = &commercelayer.AddressCreateDataRelationships{
+			Geocoder: &commercelayer.AddressCreateDataRelationshipsGeocoder{
+				Data: commercelayer.AddressDataRelationshipsGeocoderData{
+					Type: stringRef(geocoderType),
+					Id:   geocoderId,
+				}},
+		}
 	}
 
 	err := d.Set("type", addressType)
@@ -258,43 +160,322 @@ func resourceAddressUpdateFunc(ctx context.Context, d *schema.ResourceData, i in
 
 	var addressUpdate = commercelayer.AddressUpdate{
 		Data: commercelayer.AddressUpdateData{
-			Type: addressType,
-			Id:   d.Id(),
-			Attributes: commercelayer.PATCHAddressesAddressId200ResponseDataAttributes{
-				Business:        boolRef(attributes["business"]),
-				FirstName:       stringRef(attributes["first_name"]),
-				LastName:        stringRef(attributes["last_name"]),
-				Company:         stringRef(attributes["company"]),
-				Line1:           stringRef(attributes["line_1"]),
-				Line2:           stringRef(attributes["line_2"]),
-				City:            stringRef(attributes["city"]),
-				ZipCode:         stringRef(attributes["zip_code"]),
-				StateCode:       stringRef(attributes["state_code"]),
-				CountryCode:     stringRef(attributes["country_code"]),
-				Phone:           stringRef(attributes["phone"]),
-				Email:           stringRef(attributes["email"]),
-				Notes:           stringRef(attributes["notes"]),
-				Lat:             float64ToFloat32Ref(attributes["lat"]),
-				Lng:             float64ToFloat32Ref(attributes["lng"]),
-				BillingInfo:     stringRef(attributes["billing_info"]),
-				Reference:       stringRef(attributes["reference"]),
-				ReferenceOrigin: stringRef(attributes["reference_origin"]),
-				Metadata:        keyValueRef(attributes["metadata"]),
-			},
+			Type:       addressType,
+			Id:         d.Id(),
+			Attributes: addressUpdateAttributes(attributes),
 		},
 	}
 
 	geocoderId := stringRef(relationships["geocoder_id"])
 	if geocoderId != nil {
-		addressUpdate.Data.Relationships.Geocoder = &commercelayer.AddressCreateDataRelationshipsGeocoder{
-			Data: commercelayer.AddressDataRelationshipsGeocoderData{
-				Type: stringRef(geocoderType),
-				Id:   geocoderId,
-			}}
+		addressUpdate.Data.Relationships = &commercelayer.AddressCreateDataRelationships{
+			Geocoder: &commercelayer.AddressCreateDataRelationshipsGeocoder{
+				Data: commercelayer.AddressDataRelationshipsGeocoderData{
+					Type: stringRef(geocoderType),
+					Id:   geocoderId,
+				}},
+		}
 	}
 
 	_, _, err := c.AddressesApi.PATCHAddressesAddressId(ctx, d.Id()).AddressUpdate(addressUpdate).Execute()
 
 	return diag.FromErr(err)
+}
+
+// setAddressGeocoding sets the computed geocoding results of an address. These are kept out of the attributes, so
+// the formatting applied by Commercelayer never shows up as a diff on the configured values. They are only returned
+// by a GET, so they are set when the address is refreshed rather than right after it is created or updated.
+func setAddressGeocoding(d *schema.ResourceData, attributes commercelayer.GETAddresses200ResponseDataInnerAttributes) error {
+	values := map[string]interface{}{
+		"is_geocoded":   attributes.GetIsGeocoded(),
+		"geocoded_lat":  float64(attributes.GetLat()),
+		"geocoded_lng":  float64(attributes.GetLng()),
+		"full_address":  attributes.GetFullAddress(),
+		"provider_name": attributes.GetProviderName(),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("failed to set %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// addressAttributesSchema is the schema of the address attributes, which are also used by resources that can own an
+// inline address
+func addressAttributesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"business": {
+			Description: "Indicates if it's a business or a personal address",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+		"first_name": {
+			Description: "Address first name",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"last_name": {
+			Description: "Address last name",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"company": {
+			Description: "Address company name",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"line_1": {
+			Description: "Address line 1, i.e. Street address, PO Box",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"line_2": {
+			Description: "Address line 2, i.e. Apartment, Suite, Building",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"city": {
+			Description: "Address city",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"zip_code": {
+			Description: "ZIP or postal code",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"state_code": {
+			Description: "State, province or region code",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"country_code": {
+			Description: "The international 2-letter country code as defined by the ISO 3166-1 standard",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"phone": {
+			Description: "Phone number (including extension).",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"email": {
+			Description: "Email address",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"notes": {
+			Description: "A free notes attached to the address. When used as a shipping address, this " +
+				"can be useful to let the customers add specific delivery instructions.",
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"lat": {
+			Description: "The address geocoded latitude. This is automatically generated when " +
+				"creating a shipping/billing address for an order and a valid geocoder is attached to " +
+				"the order's market.",
+			Type:     schema.TypeFloat,
+			Optional: true,
+		},
+		"lng": {
+			Description: "The address geocoded longitude. This is automatically generated when " +
+				"creating a shipping/billing address for an order and a valid geocoder is attached " +
+				"to the order's market.",
+			Type:     schema.TypeFloat,
+			Optional: true,
+		},
+		"billing_info": {
+			Description: "Customer's billing information (i.e. VAT number, codice fiscale)",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"reference": {
+			Description: "A string that you can use to add any external identifier to the resource. This " +
+				"can be useful for integrating the resource to an external system, like an ERP, a " +
+				"marketing tool, a CRM, or whatever.",
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"reference_origin": {
+			Description: "Any identifier of the third party system that defines the reference code",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"metadata": {
+			Description: "Set of key-value pairs that you can attach to the resource. This can be useful " +
+				"for storing additional information about the resource in a structured format",
+			Type: schema.TypeMap,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional: true,
+		},
+	}
+}
+
+func addressCreateAttributes(attributes map[string]any) commercelayer.POSTAddresses201ResponseDataAttributes {
+	return commercelayer.POSTAddresses201ResponseDataAttributes{
+		Business:        boolRef(attributes["business"]),
+		FirstName:       stringRef(attributes["first_name"]),
+		LastName:        stringRef(attributes["last_name"]),
+		Company:         stringRef(attributes["company"]),
+		Line1:           attributes["line_1"].(string),
+		Line2:           stringRef(attributes["line_2"]),
+		City:            attributes["city"].(string),
+		ZipCode:         stringRef(attributes["zip_code"]),
+		StateCode:       attributes["state_code"].(string),
+		CountryCode:     attributes["country_code"].(string),
+		Phone:           attributes["phone"].(string),
+		Email:           stringRef(attributes["email"]),
+		Notes:           stringRef(attributes["notes"]),
+		Lat:             float64ToFloat32Ref(attributes["lat"]),
+		Lng:             float64ToFloat32Ref(attributes["lng"]),
+		BillingInfo:     stringRef(attributes["billing_info"]),
+		Reference:       stringRef(attributes["reference"]),
+		ReferenceOrigin: stringRef(attributes["reference_origin"]),
+		Metadata:        keyValueRef(attributes["metadata"]),
+	}
+}
+
+func addressUpdateAttributes(attributes map[string]any) commercelayer.PATCHAddressesAddressId200ResponseDataAttributes {
+	return commercelayer.PATCHAddressesAddressId200ResponseDataAttributes{
+		Business:        boolRef(attributes["business"]),
+		FirstName:       stringRef(attributes["first_name"]),
+		LastName:        stringRef(attributes["last_name"]),
+		Company:         stringRef(attributes["company"]),
+		Line1:           stringRef(attributes["line_1"]),
+		Line2:           stringRef(attributes["line_2"]),
+		City:            stringRef(attributes["city"]),
+		ZipCode:         stringRef(attributes["zip_code"]),
+		StateCode:       stringRef(attributes["state_code"]),
+		CountryCode:     stringRef(attributes["country_code"]),
+		Phone:           stringRef(attributes["phone"]),
+		Email:           stringRef(attributes["email"]),
+		Notes:           stringRef(attributes["notes"]),
+		Lat:             float64ToFloat32Ref(attributes["lat"]),
+		Lng:             float64ToFloat32Ref(attributes["lng"]),
+		BillingInfo:     stringRef(attributes["billing_info"]),
+		Reference:       stringRef(attributes["reference"]),
+		ReferenceOrigin: stringRef(attributes["reference_origin"]),
+		Metadata:        keyValueRef(attributes["metadata"]),
+	}
+}
+
+// syncInlineAddress returns the address id of a resource that either defines its address inline, in an address
+// block, or references it with relationships.0.address_id. An inline address is created, or updated if the resource
+// already owns it.
+func syncInlineAddress(ctx context.Context, c *commercelayer.APIClient, d *schema.ResourceData) (string, error) {
+	address := nestedMap(d.Get("address"))
+	if len(address) == 0 {
+		addressId, _ := nestedMap(d.Get("relationships"))["address_id"].(string)
+		return addressId, d.Set("address_id", addressId)
+	}
+
+	oldAddress, _ := d.GetChange("address")
+	if len(oldAddress.([]any)) > 0 {
+		addressId := d.Get("address_id").(string)
+		if !d.HasChange("address") {
+			return addressId, nil
+		}
+
+		addressUpdate := commercelayer.AddressUpdate{
+			Data: commercelayer.AddressUpdateData{
+				Type:       addressType,
+				Id:         addressId,
+				Attributes: addressUpdateAttributes(address),
+			},
+		}
+		_, _, err := c.AddressesApi.PATCHAddressesAddressId(ctx, addressId).AddressUpdate(addressUpdate).Execute()
+		return addressId, err
+	}
+
+	addressCreate := commercelayer.AddressCreate{
+		Data: commercelayer.AddressCreateData{
+			Type:       addressType,
+			Attributes: addressCreateAttributes(address),
+		},
+	}
+	resp, _, err := c.AddressesApi.POSTAddresses(ctx).AddressCreate(addressCreate).Execute()
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Data.GetId(), d.Set("address_id", resp.Data.GetId())
+}
+
+// discardInlineAddress is called when the resource owning an inline address could not be saved. It deletes the
+// address that syncInlineAddress created for it, if any, and keeps the previous state of the resource, so a failed
+// apply neither leaves the address behind nor loses track of the address the resource still refers to.
+func discardInlineAddress(ctx context.Context, c *commercelayer.APIClient, d *schema.ResourceData, previousAddressId string, addressId string) {
+	d.Partial(true)
+
+	if len(nestedMap(d.Get("address"))) == 0 || addressId == previousAddressId {
+		return
+	}
+
+	_, _ = c.AddressesApi.DELETEAddressesAddressId(ctx, addressId).Execute()
+}
+
+// readInlineAddress reads the address of a resource that can own an inline address from its address relationship,
+// e.g. /merchants/xYZkjABcde/address, and flattens it into the address block. An address referenced with
+// relationships.0.address_id is managed on its own and left untouched, while an imported resource has neither of
+// them yet and gets its address inline.
+func readInlineAddress(ctx context.Context, c *commercelayer.APIClient, d *schema.ResourceData, path string) error {
+	if addressId, _ := nestedMap(d.Get("relationships"))["address_id"].(string); addressId != "" {
+		return nil
+	}
+
+	address, err := getResource(ctx, c, path, nil)
+	if err != nil {
+		return err
+	}
+
+	var attributes commercelayer.GETAddresses200ResponseDataInnerAttributes
+	if err = address.decodeAttributes(&attributes); err != nil {
+		return err
+	}
+
+	if err = d.Set("address_id", address.Id); err != nil {
+		return err
+	}
+
+	return d.Set("address", []any{flattenAddressAttributes(attributes, nestedMap(d.Get("address")))})
+}
+
+// flattenAddressAttributes flattens the attributes of an address into an address block. Once an address has been
+// geocoded its lat and lng are the ones of the geocoder, so the configured ones are kept instead.
+func flattenAddressAttributes(attributes commercelayer.GETAddresses200ResponseDataInnerAttributes, configured map[string]any) map[string]any {
+	address := map[string]any{
+		"business":         attributes.GetBusiness(),
+		"first_name":       attributes.GetFirstName(),
+		"last_name":        attributes.GetLastName(),
+		"company":          attributes.GetCompany(),
+		"line_1":           attributes.GetLine1(),
+		"line_2":           attributes.GetLine2(),
+		"city":             attributes.GetCity(),
+		"zip_code":         attributes.GetZipCode(),
+		"state_code":       attributes.GetStateCode(),
+		"country_code":     attributes.GetCountryCode(),
+		"phone":            attributes.GetPhone(),
+		"email":            attributes.GetEmail(),
+		"notes":            attributes.GetNotes(),
+		"lat":              configured["lat"],
+		"lng":              configured["lng"],
+		"billing_info":     attributes.GetBillingInfo(),
+		"reference":        attributes.GetReference(),
+		"reference_origin": attributes.GetReferenceOrigin(),
+		"metadata":         attributes.GetMetadata(),
+	}
+
+	if !attributes.GetIsGeocoded() {
+		// the SDK decodes the coordinates as float32, formatting them with the float32 precision restores them exactly
+		address["lat"], _ = strconv.ParseFloat(strconv.FormatFloat(float64(attributes.GetLat()), 'f', -1, 32), 64)
+		address["lng"], _ = strconv.ParseFloat(strconv.FormatFloat(float64(attributes.GetLng()), 'f', -1, 32), 64)
+	}
 
+	return address
 }
@@ -64,6 +64,8 @@ func (s *AcceptanceSuite) TestAccAddress_basic() {
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.phone", "020 409 0444"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.state_code", "NH"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.bar", "foo"),
+					resource.TestCheckResourceAttrSet(resourceName, "full_address"),
+					resource.TestCheckResourceAttr(resourceName, "is_geocoded", "false"),
 				),
 			},
 		},
@@ -4,7 +4,9 @@ import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"regexp"
 )
 
 func resourceAdyenGateway() *schema.Resource {
@@ -15,9 +17,9 @@ func resourceAdyenGateway() *schema.Resource {
 			"To create a Adyen gateway choose a meaningful name that helps you identify it within your organization and gather all the credentials requested " +
 			"(like secret and publishable keys, etc. — contact Adyen's support if you are not sure about the requested data).",
 		ReadContext:   resourceAdyenGatewayReadFunc,
-		CreateContext: resourceAdyenGatewayCreateFunc,
+		CreateContext: adoptableCreateFunc(adyenGatewaysType, resourceAdyenGatewayCreateFunc, resourceAdyenGatewayUpdateFunc),
 		UpdateContext: resourceAdyenGatewayUpdateFunc,
-		DeleteContext: resourceAdyenGatewayDeleteFunc,
+		DeleteContext: retainableDeleteFunc(resourceAdyenGatewayDeleteFunc),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -32,6 +34,13 @@ func resourceAdyenGateway() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"retain_on_destroy": retainOnDestroySchema(),
+			"webhook_endpoint_url": {
+				Description: "The gateway webhook URL, generated automatically. Configure it as the notification " +
+					"webhook in the Adyen customer area when async_api is enabled.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"attributes": {
 				Description: "Resource attributes",
 				Type:        schema.TypeList,
@@ -56,9 +65,12 @@ func resourceAdyenGateway() *schema.Resource {
 							Required:    true,
 						},
 						"api_version": {
-							Description: "The checkout API version, supported range is from 66 to 68, default is 68.",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Description: "The checkout API version, e.g. 68. Commercelayer checks which versions it " +
+								"supports, at the time of writing from 66 to 68, default is 68.",
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateDiagFunc: validation.ToDiagFunc(
+								validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be a numeric API version")),
 						},
 						"async_api": {
 							Description: "Indicates if the gateway will leverage on the Adyen notification webhooks.",
@@ -114,7 +126,7 @@ func resourceAdyenGatewayReadFunc(ctx context.Context, d *schema.ResourceData, i
 
 	resp, _, err := c.AdyenGatewaysApi.GETAdyenGatewaysAdyenGatewayId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	adyenGateway, ok := resp.GetDataOk()
@@ -125,6 +137,12 @@ func resourceAdyenGatewayReadFunc(ctx context.Context, d *schema.ResourceData, i
 
 	d.SetId(adyenGateway.GetId())
 
+	attributes := adyenGateway.GetAttributes()
+	err = d.Set("webhook_endpoint_url", attributes.GetWebhookEndpointUrl())
+	if err != nil {
+		return diagErr(err)
+	}
+
 	return nil
 }
 
@@ -164,7 +182,7 @@ func resourceAdyenGatewayCreateFunc(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(*adyenGateway.Data.Id)
 
-	return nil
+	return resourceAdyenGatewayReadFunc(ctx, d, i)
 }
 
 func resourceAdyenGatewayDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -200,6 +218,9 @@ func resourceAdyenGatewayUpdateFunc(ctx context.Context, d *schema.ResourceData,
 
 	_, _, err := c.AdyenGatewaysApi.PATCHAdyenGatewaysAdyenGatewayId(ctx, d.Id()).
 		AdyenGatewayUpdate(adyenGatewayUpdate).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	return diag.FromErr(err)
+	return resourceAdyenGatewayReadFunc(ctx, d, i)
 }
@@ -6,6 +6,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"github.com/stretchr/testify/assert"
+	"testing"
 )
 
 func testAccCheckAdyenGatewayDestroy(s *terraform.State) error {
@@ -46,6 +48,7 @@ func (s *AcceptanceSuite) TestAccAdyenGateway_basic() {
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.name", "Incentro Adyen Gateway"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.foo", "bar"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.api_version", "68"),
+					resource.TestCheckResourceAttrSet(resourceName, "webhook_endpoint_url"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.async_api", "true"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.webhook_endpoint_secret", "foobar"),
 				),
@@ -105,3 +108,25 @@ func testAccAdyenGatewayUpdate(testName string) string {
 	}
 `, map[string]any{"testName": testName})
 }
+
+func TestAdyenGatewayApiVersion(t *testing.T) {
+	attributes := func(apiVersion string) map[string]any {
+		return map[string]any{"attributes": []any{map[string]any{
+			"name":             "Incentro Adyen Gateway",
+			"merchant_account": "xxxx-yyyy-zzzz",
+			"api_key":          "xxxx-yyyy-zzzz",
+			"live_url_prefix":  "1797a841fbb37ca7-AdyenDemo",
+			"api_version":      apiVersion,
+		}}}
+	}
+
+	for _, apiVersion := range []string{"68", "69", "71"} {
+		diags := resourceAdyenGateway().Validate(terraform.NewResourceConfigRaw(attributes(apiVersion)))
+		assert.False(t, diags.HasError(), apiVersion)
+	}
+
+	for _, apiVersion := range []string{"v68", "68.1", ""} {
+		diags := resourceAdyenGateway().Validate(terraform.NewResourceConfigRaw(attributes(apiVersion)))
+		assert.True(t, diags.HasError(), apiVersion)
+	}
+}
@@ -0,0 +1,183 @@
+package commercelayer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func resourceBillingInfoValidationRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Billing info validation rules are order validation rules that check the billing info of an " +
+			"order placed in the associated market, for example to enforce a VAT number for B2B customers.",
+		ReadContext:   resourceBillingInfoValidationRuleReadFunc,
+		CreateContext: resourceBillingInfoValidationRuleCreateFunc,
+		UpdateContext: resourceBillingInfoValidationRuleUpdateFunc,
+		DeleteContext: resourceBillingInfoValidationRuleDeleteFunc,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The billing info validation rule unique identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The resource type",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"attributes": {
+				Description: "Resource attributes",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"reference": {
+							Description: "A string that you can use to add any external identifier to the resource. This " +
+								"can be useful for integrating the resource to an external system, like an ERP, a " +
+								"marketing tool, a CRM, or whatever.",
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"reference_origin": {
+							Description: "Any identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"metadata": {
+							Description: "Set of key-value pairs that you can attach to the resource. This can be useful " +
+								"for storing additional information about the resource in a structured format",
+							Type: schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Optional: true,
+						},
+					},
+				},
+			},
+			"relationships": {
+				Description: "Resource relationships",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				MinItems:    1,
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"market_id": {
+							Description: "The associated market id.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBillingInfoValidationRuleReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	resp, _, err := c.BillingInfoValidationRulesApi.
+		GETBillingInfoValidationRulesBillingInfoValidationRuleId(ctx, d.Id()).Execute()
+	if err != nil {
+		return diagReadErr(d, err)
+	}
+
+	billingInfoValidationRule, ok := resp.GetDataOk()
+	if !ok {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(billingInfoValidationRule.GetId())
+
+	return nil
+}
+
+func resourceBillingInfoValidationRuleCreateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	attributes := nestedMap(d.Get("attributes"))
+	relationships := nestedMap(d.Get("relationships"))
+
+	billingInfoValidationRuleCreate := commercelayer.BillingInfoValidationRuleCreate{
+		Data: commercelayer.BillingInfoValidationRuleCreateData{
+			Type: billingInfoValidationRulesType,
+			Attributes: commercelayer.POSTAdyenPayments201ResponseDataAttributes{
+				Reference:       stringRef(attributes["reference"]),
+				ReferenceOrigin: stringRef(attributes["reference_origin"]),
+				Metadata:        keyValueRef(attributes["metadata"]),
+			},
+			Relationships: &commercelayer.BillingInfoValidationRuleCreateDataRelationships{
+				Market: commercelayer.BillingInfoValidationRuleCreateDataRelationshipsMarket{
+					Data: commercelayer.AvalaraAccountDataRelationshipsMarketsData{
+						Type: stringRef(marketType),
+						Id:   stringRef(relationships["market_id"]),
+					},
+				},
+			},
+		},
+	}
+
+	err := d.Set("type", billingInfoValidationRulesType)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	billingInfoValidationRule, _, err := c.BillingInfoValidationRulesApi.POSTBillingInfoValidationRules(ctx).
+		BillingInfoValidationRuleCreate(billingInfoValidationRuleCreate).Execute()
+	if err != nil {
+		return diagErr(err)
+	}
+
+	d.SetId(*billingInfoValidationRule.Data.Id)
+
+	return nil
+}
+
+func resourceBillingInfoValidationRuleDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+	_, err := c.BillingInfoValidationRulesApi.
+		DELETEBillingInfoValidationRulesBillingInfoValidationRuleId(ctx, d.Id()).Execute()
+	return diag.FromErr(err)
+}
+
+func resourceBillingInfoValidationRuleUpdateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	attributes := nestedMap(d.Get("attributes"))
+	relationships := nestedMap(d.Get("relationships"))
+
+	var billingInfoValidationRuleUpdate = commercelayer.BillingInfoValidationRuleUpdate{
+		Data: commercelayer.BillingInfoValidationRuleUpdateData{
+			Type: billingInfoValidationRulesType,
+			Id:   d.Id(),
+			Attributes: commercelayer.POSTAdyenPayments201ResponseDataAttributes{
+				Reference:       stringRef(attributes["reference"]),
+				ReferenceOrigin: stringRef(attributes["reference_origin"]),
+				Metadata:        keyValueRef(attributes["metadata"]),
+			},
+			Relationships: &commercelayer.BillingInfoValidationRuleUpdateDataRelationships{
+				Market: &commercelayer.BillingInfoValidationRuleCreateDataRelationshipsMarket{
+					Data: commercelayer.AvalaraAccountDataRelationshipsMarketsData{
+						Type: stringRef(marketType),
+						Id:   stringRef(relationships["market_id"]),
+					},
+				},
+			},
+		},
+	}
+
+	_, _, err := c.BillingInfoValidationRulesApi.
+		PATCHBillingInfoValidationRulesBillingInfoValidationRuleId(ctx, d.Id()).
+		BillingInfoValidationRuleUpdate(billingInfoValidationRuleUpdate).Execute()
+
+	return diag.FromErr(err)
+}
@@ -0,0 +1,109 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func testAccCheckBillingInfoValidationRuleDestroy(s *terraform.State) error {
+	client := testAccProviderCommercelayer.Meta().(*commercelayer.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "commercelayer_billing_info_validation_rule" {
+			_, resp, err := client.BillingInfoValidationRulesApi.
+				GETBillingInfoValidationRulesBillingInfoValidationRuleId(context.Background(), rs.Primary.ID).Execute()
+			if resp.StatusCode == 404 {
+				fmt.Printf("commercelayer_billing_info_validation_rule with id %s has been removed\n", rs.Primary.ID)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("received response code with status %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+func (s *AcceptanceSuite) TestAccBillingInfoValidationRule_basic() {
+	resourceName := "commercelayer_billing_info_validation_rule.incentro_billing_info_validation_rule"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckBillingInfoValidationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccAddressCreate(resourceName),
+					testAccInventoryModelCreate(resourceName),
+					testAccMerchantCreate(resourceName),
+					testAccPriceListCreate(resourceName),
+					testAccExternalTaxCalculatorCreate(resourceName),
+					testAccMarketCreate(resourceName),
+					testAccBillingInfoValidationRuleCreate(resourceName)}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", billingInfoValidationRulesType),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.foo", "bar"),
+				),
+			},
+			{
+				Config: strings.Join([]string{
+					testAccAddressCreate(resourceName),
+					testAccInventoryModelCreate(resourceName),
+					testAccMerchantCreate(resourceName),
+					testAccPriceListCreate(resourceName),
+					testAccExternalTaxCalculatorCreate(resourceName),
+					testAccMarketCreate(resourceName),
+					testAccBillingInfoValidationRuleUpdate(resourceName)}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.bar", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBillingInfoValidationRuleCreate(testName string) string {
+	return hclTemplate(`
+		resource "commercelayer_billing_info_validation_rule" "incentro_billing_info_validation_rule" {
+		  attributes {
+			metadata = {
+			  foo : "bar"
+			  testName: "{{.testName}}"
+			}
+		  }
+
+		  relationships {
+			market_id = commercelayer_market.incentro_market.id
+		  }
+		}
+	`, map[string]any{"testName": testName})
+}
+
+func testAccBillingInfoValidationRuleUpdate(testName string) string {
+	return hclTemplate(`
+		resource "commercelayer_billing_info_validation_rule" "incentro_billing_info_validation_rule" {
+		  attributes {
+			metadata = {
+			  bar : "foo"
+			  testName: "{{.testName}}"
+			}
+		  }
+
+		  relationships {
+			market_id = commercelayer_market.incentro_market.id
+		  }
+		}
+	`, map[string]any{"testName": testName})
+}
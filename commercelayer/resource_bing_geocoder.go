@@ -14,9 +14,9 @@ func resourceBingGeocoders() *schema.Resource {
 			"By connecting a geocoder to a market, all the shipping and billing addresses belonging " +
 			"to that market orders will be geocoded",
 		ReadContext:   resourceBingGeocodersReadFunc,
-		CreateContext: resourceBingGeocodersCreateFunc,
+		CreateContext: adoptableCreateFunc(bingGeocodersType, resourceBingGeocodersCreateFunc, resourceBingGeocodersUpdateFunc),
 		UpdateContext: resourceBingGeocodersUpdateFunc,
-		DeleteContext: resourceBingGeocodersDeleteFunc,
+		DeleteContext: retainableDeleteFunc(resourceBingGeocodersDeleteFunc),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -31,6 +31,7 @@ func resourceBingGeocoders() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"retain_on_destroy": retainOnDestroySchema(),
 			"attributes": {
 				Description: "Resource attributes",
 				Type:        schema.TypeList,
@@ -81,7 +82,7 @@ func resourceBingGeocodersReadFunc(ctx context.Context, d *schema.ResourceData,
 
 	resp, _, err := c.BingGeocodersApi.GETBingGeocodersBingGeocoderId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	bingGeocoder, ok := resp.GetDataOk()
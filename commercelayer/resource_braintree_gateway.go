@@ -14,9 +14,9 @@ func resourceBraintreeGateway() *schema.Resource {
 			"so that you can implement a payment flow that supports SCA and 3DS2 by using the " +
 			"Braintree official JS SDK and libraries.",
 		ReadContext:   resourceBraintreeGatewayReadFunc,
-		CreateContext: resourceBraintreeGatewayCreateFunc,
+		CreateContext: adoptableCreateFunc(braintreeGatewaysType, resourceBraintreeGatewayCreateFunc, resourceBraintreeGatewayUpdateFunc),
 		UpdateContext: resourceBraintreeGatewayUpdateFunc,
-		DeleteContext: resourceBraintreeGatewayDeleteFunc,
+		DeleteContext: retainableDeleteFunc(resourceBraintreeGatewayDeleteFunc),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -31,6 +31,7 @@ func resourceBraintreeGateway() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"retain_on_destroy": retainOnDestroySchema(),
 			"attributes": {
 				Description: "Resource attributes",
 				Type:        schema.TypeList,
@@ -115,7 +116,7 @@ func resourceBraintreeGatewayReadFunc(ctx context.Context, d *schema.ResourceDat
 
 	resp, _, err := c.BraintreeGatewaysApi.GETBraintreeGatewaysBraintreeGatewayId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	braintreeGateway, ok := resp.GetDataOk()
@@ -14,9 +14,9 @@ func resourceCheckoutComGateway() *schema.Resource {
 			"implement a payment flow that supports SCA and 3DS2 by using the CheckoutCom's official " +
 			"JS SDK and libraries.",
 		ReadContext:   resourceCheckoutComGatewayReadFunc,
-		CreateContext: resourceCheckoutComGatewayCreateFunc,
+		CreateContext: adoptableCreateFunc(checkoutComGatewaysType, resourceCheckoutComGatewayCreateFunc, resourceCheckoutComGatewayUpdateFunc),
 		UpdateContext: resourceCheckoutComGatewayUpdateFunc,
-		DeleteContext: resourceCheckoutComGatewayDeleteFunc,
+		DeleteContext: retainableDeleteFunc(resourceCheckoutComGatewayDeleteFunc),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -31,6 +31,23 @@ func resourceCheckoutComGateway() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"retain_on_destroy": retainOnDestroySchema(),
+			"webhook_endpoint_id": {
+				Description: "The gateway webhook endpoint ID, generated automatically.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"webhook_endpoint_secret": {
+				Description: "The gateway webhook endpoint secret, generated automatically.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"webhook_endpoint_url": {
+				Description: "The gateway webhook URL, generated automatically.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"attributes": {
 				Description: "Resource attributes",
 				Type:        schema.TypeList,
@@ -87,7 +104,7 @@ func resourceCheckoutComGatewayReadFunc(ctx context.Context, d *schema.ResourceD
 
 	resp, _, err := c.CheckoutComGatewaysApi.GETCheckoutComGatewaysCheckoutComGatewayId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	checkoutComGateway, ok := resp.GetDataOk()
@@ -98,6 +115,19 @@ func resourceCheckoutComGatewayReadFunc(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(checkoutComGateway.GetId())
 
+	attributes := checkoutComGateway.GetAttributes()
+	values := map[string]interface{}{
+		"webhook_endpoint_id":     attributes.GetWebhookEndpointId(),
+		"webhook_endpoint_secret": attributes.GetWebhookEndpointSecret(),
+		"webhook_endpoint_url":    attributes.GetWebhookEndpointUrl(),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
 	return nil
 }
 
@@ -132,7 +162,7 @@ func resourceCheckoutComGatewayCreateFunc(ctx context.Context, d *schema.Resourc
 
 	d.SetId(*checkoutComGateway.Data.Id)
 
-	return nil
+	return resourceCheckoutComGatewayReadFunc(ctx, d, i)
 }
 
 func resourceCheckoutComGatewayDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -161,6 +191,9 @@ func resourceCheckoutComGatewayUpdateFunc(ctx context.Context, d *schema.Resourc
 
 	_, _, err := c.CheckoutComGatewaysApi.PATCHCheckoutComGatewaysCheckoutComGatewayId(ctx, d.Id()).
 		CheckoutComGatewayUpdate(checkoutComGatewayUpdate).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	return diag.FromErr(err)
+	return resourceCheckoutComGatewayReadFunc(ctx, d, i)
 }
@@ -46,6 +46,7 @@ func (s *AcceptanceSuite) TestAccCheckoutComGateway_basic() {
 					resource.TestCheckResourceAttr(resourceName, "type", checkoutComGatewaysType),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.name", "Incentro CheckoutCom Gateway"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.foo", "bar"),
+					resource.TestCheckResourceAttrSet(resourceName, "webhook_endpoint_url"),
 				),
 			},
 			{
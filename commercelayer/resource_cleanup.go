@@ -0,0 +1,227 @@
+package commercelayer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func resourceCleanup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Cleanups are asynchronous jobs that delete all the resources of a given type, optionally " +
+			"filtered. The cleanup is submitted on create and the apply waits until it has either completed or " +
+			"been interrupted. Cleanups can not be updated, any change to the attributes submits a new cleanup. " +
+			"Use with care, deleted resources can not be recovered. The cleanup is meant to reset test mode " +
+			"organizations and refuses to run with the credentials of a live mode application.",
+		ReadContext:   resourceCleanupReadFunc,
+		CreateContext: resourceCleanupCreateFunc,
+		DeleteContext: resourceCleanupDeleteFunc,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The cleanup unique identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The resource type",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"attributes": {
+				Description: "Resource attributes",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				MinItems:    1,
+				Required:    true,
+				ForceNew:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Description: "The type of resource being cleaned, e.g. skus or prices.",
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+						},
+						"filters": {
+							Description: "The JSON encoded filters used to select the records to be cleaned, " +
+								"e.g. jsonencode({ code_start = \"TSHIRT\" }).",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+						},
+						"reference": {
+							Description: "A string that you can use to add any external identifier to the resource. This " +
+								"can be useful for integrating the resource to an external system, like an ERP, a " +
+								"marketing tool, a CRM, or whatever.",
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"reference_origin": {
+							Description: "Any identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"metadata": {
+							Description: "Set of key-value pairs that you can attach to the resource. This can be useful " +
+								"for storing additional information about the resource in a structured format",
+							Type: schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Description: "The cleanup job status, one of 'pending', 'in_progress', 'interrupted' or 'completed'.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"records_count": {
+				Description: "The number of records found.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"processed_count": {
+				Description: "The number of records that have been cleaned.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"errors_count": {
+				Description: "The number of errors raised during the cleanup.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"errors_log": {
+				Description: "The JSON encoded errors, if any.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceCleanupReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	resp, _, err := c.CleanupsApi.GETCleanupsCleanupId(ctx, d.Id()).Execute()
+	if err != nil {
+		return diagReadErr(d, err)
+	}
+
+	cleanup, ok := resp.GetDataOk()
+	if !ok {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(cleanup.GetId())
+
+	return diagErr(setCleanupStatus(d, cleanup.GetAttributes()))
+}
+
+func resourceCleanupCreateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	err := requireTestMode(c)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	attributes := nestedMap(d.Get("attributes"))
+
+	var filters map[string]interface{}
+	if rawFilters := attributes["filters"].(string); rawFilters != "" {
+		err := json.Unmarshal([]byte(rawFilters), &filters)
+		if err != nil {
+			return diag.Errorf("filters must be a JSON encoded object: %s", err)
+		}
+	}
+
+	cleanupCreate := commercelayer.CleanupCreate{
+		Data: commercelayer.CleanupCreateData{
+			Type: cleanupsType,
+			Attributes: commercelayer.POSTCleanups201ResponseDataAttributes{
+				ResourceType:    attributes["resource_type"].(string),
+				Filters:         filters,
+				Reference:       stringRef(attributes["reference"]),
+				ReferenceOrigin: stringRef(attributes["reference_origin"]),
+				Metadata:        keyValueRef(attributes["metadata"]),
+			},
+		},
+	}
+
+	err = d.Set("type", cleanupsType)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	cleanup, _, err := c.CleanupsApi.POSTCleanups(ctx).CleanupCreate(cleanupCreate).Execute()
+	if err != nil {
+		return diagErr(err)
+	}
+
+	d.SetId(*cleanup.Data.Id)
+
+	return waitForJob(ctx, d, "cleanup", func() (jobStatus, error) {
+		resp, _, err := c.CleanupsApi.GETCleanupsCleanupId(ctx, d.Id()).Execute()
+		if err != nil {
+			return jobStatus{}, err
+		}
+
+		attributes := resp.Data.GetAttributes()
+		if err := setCleanupStatus(d, attributes); err != nil {
+			return jobStatus{}, err
+		}
+
+		return jobStatus{status: attributes.GetStatus(), errorsLog: d.Get("errors_log").(string)}, nil
+	})
+}
+
+func resourceCleanupDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+	_, err := c.CleanupsApi.DELETECleanupsCleanupId(ctx, d.Id()).Execute()
+	return diag.FromErr(err)
+}
+
+func setCleanupStatus(d *schema.ResourceData, attributes commercelayer.GETCleanups200ResponseDataInnerAttributes) error {
+	errorsLog, err := json.Marshal(attributes.GetErrorsLog())
+	if err != nil {
+		return err
+	}
+
+	values := map[string]interface{}{
+		"status":          attributes.GetStatus(),
+		"records_count":   int(attributes.GetRecordsCount()),
+		"processed_count": int(attributes.GetProcessedCount()),
+		"errors_count":    int(attributes.GetErrorsCount()),
+		"errors_log":      string(errorsLog),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("failed to set %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
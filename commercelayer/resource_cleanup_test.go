@@ -0,0 +1,90 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAccCheckCleanupDestroy(s *terraform.State) error {
+	client := testAccProviderCommercelayer.Meta().(*commercelayer.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "commercelayer_cleanup" {
+			_, resp, err := client.CleanupsApi.GETCleanupsCleanupId(context.Background(), rs.Primary.ID).Execute()
+			if resp.StatusCode == 404 {
+				fmt.Printf("commercelayer_cleanup with id %s has been removed\n", rs.Primary.ID)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("received response code with status %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+func (s *AcceptanceSuite) TestAccCleanup_basic() {
+	resourceName := "commercelayer_cleanup.incentro_cleanup"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckCleanupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCleanupCreate(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", cleanupsType),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.resource_type", "addresses"),
+					resource.TestCheckResourceAttr(resourceName, "status", "completed"),
+					resource.TestCheckResourceAttr(resourceName, "errors_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCleanupCreate(testName string) string {
+	return hclTemplate(`
+		resource "commercelayer_cleanup" "incentro_cleanup" {
+		  attributes {
+			resource_type = "addresses"
+			filters = jsonencode({
+			  country_code_eq = "NL"
+			})
+
+			metadata = {
+			  testName: "{{.testName}}"
+			}
+		  }
+		}
+	`, map[string]any{"testName": testName})
+}
+
+func TestResourceCleanupRefusesLiveMode(t *testing.T) {
+	c := testTokenClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s %s in live mode", r.Method, r.URL.Path)
+	}, `{"organization":{"slug":"incentro"},"test":false}`)
+
+	d := schema.TestResourceDataRaw(t, resourceCleanup().Schema, map[string]interface{}{
+		"attributes": []interface{}{
+			map[string]interface{}{"resource_type": "addresses"},
+		},
+	})
+
+	diags := resourceCleanupCreateFunc(context.Background(), d, c)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, "", d.Id())
+}
@@ -75,7 +75,7 @@ func resourceCustomerGroupReadFunc(ctx context.Context, d *schema.ResourceData,
 
 	resp, _, err := c.CustomerGroupsApi.GETCustomerGroupsCustomerGroupId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	customerGroup, ok := resp.GetDataOk()
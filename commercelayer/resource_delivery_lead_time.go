@@ -2,8 +2,10 @@ package commercelayer
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
 )
 
@@ -15,6 +17,7 @@ func resourceDeliveryLeadTime() *schema.Resource {
 		CreateContext: resourceDeliveryLeadTimesCreateFunc,
 		UpdateContext: resourceDeliveryLeadTimesUpdateFunc,
 		DeleteContext: resourceDeliveryLeadTimesDeleteFunc,
+		CustomizeDiff: resourceDeliveryLeadTimesCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -38,14 +41,16 @@ func resourceDeliveryLeadTime() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"min_hours": {
-							Description: "The delivery lead minimum time (in hours) when shipping from the associated stock location with the associated shipping method.",
-							Type:        schema.TypeInt,
-							Required:    true,
+							Description:      "The delivery lead minimum time (in hours) when shipping from the associated stock location with the associated shipping method.",
+							Type:             schema.TypeInt,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
 						},
 						"max_hours": {
-							Description: "The delivery lead maximum time (in hours) when shipping from the associated stock location with the associated shipping method.",
-							Type:        schema.TypeInt,
-							Required:    true,
+							Description:      "The delivery lead maximum time (in hours) when shipping from the associated stock location with the associated shipping method.",
+							Type:             schema.TypeInt,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
 						},
 						"reference": {
 							Description: "A string that you can use to add any external identifier to the resource. This " +
@@ -96,12 +101,29 @@ func resourceDeliveryLeadTime() *schema.Resource {
 	}
 }
 
+// resourceDeliveryLeadTimesCustomizeDiff rejects a minimum time above the maximum time at plan time, instead of
+// letting the API reject it halfway through an apply
+func resourceDeliveryLeadTimesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, i interface{}) error {
+	// Values that are only known after apply can not be compared yet
+	if !d.NewValueKnown("attributes.0.min_hours") || !d.NewValueKnown("attributes.0.max_hours") {
+		return nil
+	}
+
+	minHours := d.Get("attributes.0.min_hours").(int)
+	maxHours := d.Get("attributes.0.max_hours").(int)
+	if minHours > maxHours {
+		return fmt.Errorf("min_hours (%d) must be less than or equal to max_hours (%d)", minHours, maxHours)
+	}
+
+	return nil
+}
+
 func resourceDeliveryLeadTimesReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
 	c := i.(*commercelayer.APIClient)
 
 	resp, _, err := c.DeliveryLeadTimesApi.GETDeliveryLeadTimesDeliveryLeadTimeId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	deliveryLeadTime, ok := resp.GetDataOk()
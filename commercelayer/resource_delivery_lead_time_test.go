@@ -6,8 +6,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"github.com/stretchr/testify/assert"
 	"net/http"
 	"strings"
+	"testing"
 )
 
 func testAccCheckDeliveryLeadTimeDestroy(s *terraform.State) error {
@@ -139,3 +141,24 @@ func testAccDeliveryLeadTimeUpdate(testName string) string {
 }
 	`, map[string]any{"testName": testName})
 }
+
+func TestDeliveryLeadTimeCustomizeDiff(t *testing.T) {
+	config := func(minHours, maxHours int) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]any{
+			"attributes": []any{map[string]any{
+				"min_hours": minHours,
+				"max_hours": maxHours,
+			}},
+			"relationships": []any{map[string]any{
+				"stock_location_id":  "stock_location",
+				"shipping_method_id": "shipping_method",
+			}},
+		})
+	}
+
+	_, err := resourceDeliveryLeadTime().Diff(context.Background(), nil, config(24, 48), nil)
+	assert.NoError(t, err)
+
+	_, err = resourceDeliveryLeadTime().Diff(context.Background(), nil, config(48, 24), nil)
+	assert.ErrorContains(t, err, "min_hours (48) must be less than or equal to max_hours (24)")
+}
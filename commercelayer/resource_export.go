@@ -0,0 +1,253 @@
+package commercelayer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func resourceExport() *schema.Resource {
+	return &schema.Resource{
+		Description: "Exports are asynchronous jobs that export the resources of a given type, optionally filtered, " +
+			"to a file. The export is submitted on create and the apply waits until it has either completed or " +
+			"been interrupted, after which the URL of the exported file is available as an attribute. Exports " +
+			"can not be updated, any change to the attributes submits a new export.",
+		ReadContext:   resourceExportReadFunc,
+		CreateContext: resourceExportCreateFunc,
+		DeleteContext: resourceExportDeleteFunc,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The export unique identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The resource type",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"attributes": {
+				Description: "Resource attributes",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				MinItems:    1,
+				Required:    true,
+				ForceNew:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Description: "The type of resource being exported, e.g. skus or prices.",
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+						},
+						"format": {
+							Description: "The format of the export, one of 'csv' or 'json'.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     "json",
+							ValidateDiagFunc: validation.ToDiagFunc(
+								validation.StringInSlice([]string{"csv", "json"}, false)),
+						},
+						"includes": {
+							Description: "List of related resources that should be included in the export.",
+							Type:        schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Optional: true,
+							ForceNew: true,
+						},
+						"filters": {
+							Description: "The JSON encoded filters used to select the records to be exported, " +
+								"e.g. jsonencode({ code_start = \"TSHIRT\" }).",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+						},
+						"dry_data": {
+							Description: "Send this attribute if you want to skip exporting redundant attributes " +
+								"(IDs, timestamps, blanks, etc.), useful when combining export and import to " +
+								"duplicate your dataset.",
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+						"reference": {
+							Description: "A string that you can use to add any external identifier to the resource. This " +
+								"can be useful for integrating the resource to an external system, like an ERP, a " +
+								"marketing tool, a CRM, or whatever.",
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"reference_origin": {
+							Description: "Any identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"metadata": {
+							Description: "Set of key-value pairs that you can attach to the resource. This can be useful " +
+								"for storing additional information about the resource in a structured format",
+							Type: schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Description: "The export job status, one of 'pending', 'in_progress', 'interrupted' or 'completed'.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"records_count": {
+				Description: "The number of records that have been exported.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"attachment_url": {
+				Description: "The URL of the exported file, available once the export has completed.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceExportReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	resp, _, err := c.ExportsApi.GETExportsExportId(ctx, d.Id()).Execute()
+	if err != nil {
+		return diagReadErr(d, err)
+	}
+
+	export, ok := resp.GetDataOk()
+	if !ok {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(export.GetId())
+
+	return diagErr(setExportStatus(d, export.GetAttributes()))
+}
+
+func resourceExportCreateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	attributes := nestedMap(d.Get("attributes"))
+
+	var filters map[string]interface{}
+	if rawFilters := attributes["filters"].(string); rawFilters != "" {
+		err := json.Unmarshal([]byte(rawFilters), &filters)
+		if err != nil {
+			return diag.Errorf("filters must be a JSON encoded object: %s", err)
+		}
+	}
+
+	exportCreate := commercelayer.ExportCreate{
+		Data: commercelayer.ExportCreateData{
+			Type: exportsType,
+			Attributes: commercelayer.POSTExports201ResponseDataAttributes{
+				ResourceType:    attributes["resource_type"].(string),
+				Format:          stringRef(attributes["format"]),
+				Includes:        stringSliceValueRef(attributes["includes"]),
+				Filters:         filters,
+				DryData:         boolRef(attributes["dry_data"]),
+				Reference:       stringRef(attributes["reference"]),
+				ReferenceOrigin: stringRef(attributes["reference_origin"]),
+				Metadata:        keyValueRef(attributes["metadata"]),
+			},
+		},
+	}
+
+	err := d.Set("type", exportsType)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	export, _, err := c.ExportsApi.POSTExports(ctx).ExportCreate(exportCreate).Execute()
+	if err != nil {
+		return diagErr(err)
+	}
+
+	d.SetId(*export.Data.Id)
+
+	return waitForJob(ctx, d, "export", func() (jobStatus, error) {
+		// The attributes model of the SDK has no errors log, so the export is decoded from the document instead
+		export, err := getResource(ctx, c, "/exports/"+d.Id(), nil)
+		if err != nil {
+			return jobStatus{}, err
+		}
+
+		var attributes commercelayer.GETExports200ResponseDataInnerAttributes
+		if err := export.decodeAttributes(&attributes); err != nil {
+			return jobStatus{}, err
+		}
+		if err := setExportStatus(d, attributes); err != nil {
+			return jobStatus{}, err
+		}
+
+		var logs struct {
+			ErrorsLog map[string]interface{} `json:"errors_log"`
+		}
+		if err := export.decodeAttributes(&logs); err != nil {
+			return jobStatus{}, err
+		}
+		if logs.ErrorsLog == nil {
+			logs.ErrorsLog = map[string]interface{}{}
+		}
+
+		errorsLog, err := json.Marshal(logs.ErrorsLog)
+		if err != nil {
+			return jobStatus{}, err
+		}
+
+		return jobStatus{status: attributes.GetStatus(), errorsLog: string(errorsLog)}, nil
+	})
+}
+
+func resourceExportDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+	_, err := c.ExportsApi.DELETEExportsExportId(ctx, d.Id()).Execute()
+	return diag.FromErr(err)
+}
+
+func setExportStatus(d *schema.ResourceData, attributes commercelayer.GETExports200ResponseDataInnerAttributes) error {
+	values := map[string]interface{}{
+		"status":         attributes.GetStatus(),
+		"records_count":  int(attributes.GetRecordsCount()),
+		"attachment_url": attributes.GetAttachmentUrl(),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("failed to set %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func testAccCheckExportDestroy(s *terraform.State) error {
+	client := testAccProviderCommercelayer.Meta().(*commercelayer.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "commercelayer_export" {
+			_, resp, err := client.ExportsApi.GETExportsExportId(context.Background(), rs.Primary.ID).Execute()
+			if resp.StatusCode == 404 {
+				fmt.Printf("commercelayer_export with id %s has been removed\n", rs.Primary.ID)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("received response code with status %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+func (s *AcceptanceSuite) TestAccExport_basic() {
+	resourceName := "commercelayer_export.incentro_export"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckExportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportCreate(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", exportsType),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.resource_type", "addresses"),
+					resource.TestCheckResourceAttr(resourceName, "status", "completed"),
+					resource.TestCheckResourceAttrSet(resourceName, "attachment_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccExportCreate(testName string) string {
+	return hclTemplate(`
+		resource "commercelayer_export" "incentro_export" {
+		  attributes {
+			resource_type = "addresses"
+			format        = "csv"
+			filters = jsonencode({
+			  country_code_eq = "NL"
+			})
+
+			metadata = {
+			  testName: "{{.testName}}"
+			}
+		  }
+		}
+	`, map[string]any{"testName": testName})
+}
@@ -15,9 +15,9 @@ func resourceExternalGateway() *schema.Resource {
 		a stock item in one of the market stock locations will be returned. 
 		A user can create price lists to manage international business or B2B/B2C models.`,
 		ReadContext:   resourceExternalGatewayReadFunc,
-		CreateContext: resourceExternalGatewayCreateFunc,
+		CreateContext: adoptableCreateFunc(externalGatewayType, resourceExternalGatewayCreateFunc, resourceExternalGatewayUpdateFunc),
 		UpdateContext: resourceExternalGatewayUpdateFunc,
-		DeleteContext: resourceExternalGatewayDeleteFunc,
+		DeleteContext: retainableDeleteFunc(resourceExternalGatewayDeleteFunc),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -32,6 +32,7 @@ func resourceExternalGateway() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"retain_on_destroy": retainOnDestroySchema(),
 			"attributes": {
 				Description: "Resource attributes",
 				Type:        schema.TypeList,
@@ -101,7 +102,7 @@ func resourceExternalGatewayReadFunc(ctx context.Context, d *schema.ResourceData
 
 	resp, _, err := c.ExternalGatewaysApi.GETExternalGatewaysExternalGatewayId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	externalGateway, ok := resp.GetDataOk()
@@ -30,6 +30,13 @@ func resourceExternalTaxCalculator() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"shared_secret": {
+				Description: "The shared secret used to sign the external requests payload, so the external " +
+					"service can verify they are coming from Commercelayer.",
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"attributes": {
 				Description: "Resource attributes",
 				Type:        schema.TypeList,
@@ -81,7 +88,7 @@ func resourceExternalTaxCalculatorReadFunc(ctx context.Context, d *schema.Resour
 
 	resp, _, err := c.ExternalTaxCalculatorsApi.GETExternalTaxCalculatorsExternalTaxCalculatorId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	externalTaxCalculator, ok := resp.GetDataOk()
@@ -92,6 +99,12 @@ func resourceExternalTaxCalculatorReadFunc(ctx context.Context, d *schema.Resour
 
 	d.SetId(externalTaxCalculator.GetId())
 
+	attributes := externalTaxCalculator.GetAttributes()
+	err = d.Set("shared_secret", attributes.GetSharedSecret())
+	if err != nil {
+		return diagErr(err)
+	}
+
 	return nil
 }
 
@@ -125,7 +138,7 @@ func resourceExternalTaxCalculatorCreateFunc(ctx context.Context, d *schema.Reso
 
 	d.SetId(*externalTaxCalculator.Data.Id)
 
-	return nil
+	return resourceExternalTaxCalculatorReadFunc(ctx, d, i)
 }
 
 func resourceExternalTaxCalculatorDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -154,6 +167,9 @@ func resourceExternalTaxCalculatorUpdateFunc(ctx context.Context, d *schema.Reso
 	}
 
 	_, _, err := c.ExternalTaxCalculatorsApi.PATCHExternalTaxCalculatorsExternalTaxCalculatorId(ctx, d.Id()).ExternalTaxCalculatorUpdate(ExternalTaxCalculatorUpdate).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	return diag.FromErr(err)
+	return resourceExternalTaxCalculatorReadFunc(ctx, d, i)
 }
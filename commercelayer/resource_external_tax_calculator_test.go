@@ -46,6 +46,7 @@ func (s *AcceptanceSuite) TestAccExternalTaxCalculator_basic() {
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.name", "incentro_external_tax_calculator"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.foo", "bar"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.tax_calculator_url", "https://example.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "shared_secret"),
 				),
 			},
 			{
@@ -14,9 +14,9 @@ func resourceGoogleGeocoders() *schema.Resource {
 			"By connecting a geocoder to a market, all the shipping and billing addresses belonging " +
 			"to that market orders will be geocoded",
 		ReadContext:   resourceGoogleGeocodersReadFunc,
-		CreateContext: resourceGoogleGeocodersCreateFunc,
+		CreateContext: adoptableCreateFunc(googleGeocodersType, resourceGoogleGeocodersCreateFunc, resourceGoogleGeocodersUpdateFunc),
 		UpdateContext: resourceGoogleGeocodersUpdateFunc,
-		DeleteContext: resourceGoogleGeocodersDeleteFunc,
+		DeleteContext: retainableDeleteFunc(resourceGoogleGeocodersDeleteFunc),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -31,6 +31,7 @@ func resourceGoogleGeocoders() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"retain_on_destroy": retainOnDestroySchema(),
 			"attributes": {
 				Description: "Resource attributes",
 				Type:        schema.TypeList,
@@ -81,7 +82,7 @@ func resourceGoogleGeocodersReadFunc(ctx context.Context, d *schema.ResourceData
 
 	resp, _, err := c.GoogleGeocodersApi.GETGoogleGeocodersGoogleGeocoderId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	googleGeocoder, ok := resp.GetDataOk()
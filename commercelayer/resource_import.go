@@ -0,0 +1,256 @@
+package commercelayer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func resourceImport() *schema.Resource {
+	return &schema.Resource{
+		Description: "Imports are asynchronous jobs that create or update a batch of resources of the same type. " +
+			"The import is submitted on create and the apply waits until it has either completed or been " +
+			"interrupted. Imports can not be updated, any change to the attributes submits a new import.",
+		ReadContext:   resourceImportReadFunc,
+		CreateContext: resourceImportCreateFunc,
+		DeleteContext: resourceImportDeleteFunc,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The import unique identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The resource type",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"attributes": {
+				Description: "Resource attributes",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				MinItems:    1,
+				Required:    true,
+				ForceNew:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Description: "The type of resource being imported, e.g. skus or prices.",
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+						},
+						"format": {
+							Description: "The format of the import inputs, one of 'csv' or 'json'.",
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     "json",
+							ValidateDiagFunc: validation.ToDiagFunc(
+								validation.StringInSlice([]string{"csv", "json"}, false)),
+						},
+						"parent_resource_id": {
+							Description: "The ID of the parent resource to be associated with imported data, " +
+								"e.g. the price list id when importing prices.",
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"inputs": {
+							Description: "A JSON encoded array of the objects to be imported, " +
+								"e.g. jsonencode([{ code = \"SKU01\", name = \"Sku 01\" }]).",
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsJSON),
+							DiffSuppressFunc: structure.SuppressJsonDiff,
+						},
+						"cleanup_records": {
+							Description: "Indicates if the import should cleanup records that are not included " +
+								"in the inputs array, if applicable.",
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+						"reference": {
+							Description: "A string that you can use to add any external identifier to the resource. This " +
+								"can be useful for integrating the resource to an external system, like an ERP, a " +
+								"marketing tool, a CRM, or whatever.",
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"reference_origin": {
+							Description: "Any identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"metadata": {
+							Description: "Set of key-value pairs that you can attach to the resource. This can be useful " +
+								"for storing additional information about the resource in a structured format",
+							Type: schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Description: "The import job status, one of 'pending', 'in_progress', 'interrupted' or 'completed'.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"processed_count": {
+				Description: "The number of resources that have been processed.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"warnings_count": {
+				Description: "The number of warnings raised during the import.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"errors_count": {
+				Description: "The number of errors raised during the import.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"warnings_log": {
+				Description: "The JSON encoded warnings, if any, indexed by input.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"errors_log": {
+				Description: "The JSON encoded errors, if any, indexed by input.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceImportReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	resp, _, err := c.ImportsApi.GETImportsImportId(ctx, d.Id()).Execute()
+	if err != nil {
+		return diagReadErr(d, err)
+	}
+
+	importJob, ok := resp.GetDataOk()
+	if !ok {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(importJob.GetId())
+
+	return diagErr(setImportStatus(d, importJob.GetAttributes()))
+}
+
+func resourceImportCreateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	attributes := nestedMap(d.Get("attributes"))
+
+	var inputs []map[string]interface{}
+	err := json.Unmarshal([]byte(attributes["inputs"].(string)), &inputs)
+	if err != nil {
+		return diag.Errorf("inputs must be a JSON encoded array of objects: %s", err)
+	}
+
+	importCreate := commercelayer.ImportCreate{
+		Data: commercelayer.ImportCreateData{
+			Type: importsType,
+			Attributes: commercelayer.POSTImports201ResponseDataAttributes{
+				ResourceType:     attributes["resource_type"].(string),
+				Format:           stringRef(attributes["format"]),
+				ParentResourceId: stringRef(attributes["parent_resource_id"]),
+				Inputs:           inputs,
+				CleanupRecords:   boolRef(attributes["cleanup_records"]),
+				Reference:        stringRef(attributes["reference"]),
+				ReferenceOrigin:  stringRef(attributes["reference_origin"]),
+				Metadata:         keyValueRef(attributes["metadata"]),
+			},
+		},
+	}
+
+	err = d.Set("type", importsType)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	importJob, _, err := c.ImportsApi.POSTImports(ctx).ImportCreate(importCreate).Execute()
+	if err != nil {
+		return diagErr(err)
+	}
+
+	d.SetId(*importJob.Data.Id)
+
+	return waitForJob(ctx, d, "import", func() (jobStatus, error) {
+		resp, _, err := c.ImportsApi.GETImportsImportId(ctx, d.Id()).Execute()
+		if err != nil {
+			return jobStatus{}, err
+		}
+
+		attributes := resp.Data.GetAttributes()
+		if err := setImportStatus(d, attributes); err != nil {
+			return jobStatus{}, err
+		}
+
+		return jobStatus{status: attributes.GetStatus(), errorsLog: d.Get("errors_log").(string)}, nil
+	})
+}
+
+func resourceImportDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+	_, err := c.ImportsApi.DELETEImportsImportId(ctx, d.Id()).Execute()
+	return diag.FromErr(err)
+}
+
+func setImportStatus(d *schema.ResourceData, attributes commercelayer.GETImports200ResponseDataInnerAttributes) error {
+	warningsLog, err := json.Marshal(attributes.GetWarningsLog())
+	if err != nil {
+		return err
+	}
+
+	errorsLog, err := json.Marshal(attributes.GetErrorsLog())
+	if err != nil {
+		return err
+	}
+
+	values := map[string]interface{}{
+		"status":          attributes.GetStatus(),
+		"processed_count": int(attributes.GetProcessedCount()),
+		"warnings_count":  int(attributes.GetWarningsCount()),
+		"errors_count":    int(attributes.GetErrorsCount()),
+		"warnings_log":    string(warningsLog),
+		"errors_log":      string(errorsLog),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("failed to set %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func testAccCheckImportDestroy(s *terraform.State) error {
+	client := testAccProviderCommercelayer.Meta().(*commercelayer.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "commercelayer_import" {
+			_, resp, err := client.ImportsApi.GETImportsImportId(context.Background(), rs.Primary.ID).Execute()
+			if resp.StatusCode == 404 {
+				fmt.Printf("commercelayer_import with id %s has been removed\n", rs.Primary.ID)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("received response code with status %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+func (s *AcceptanceSuite) TestAccImport_basic() {
+	resourceName := "commercelayer_import.incentro_import"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckImportDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImportCreate(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", importsType),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.resource_type", "addresses"),
+					resource.TestCheckResourceAttr(resourceName, "status", "completed"),
+					resource.TestCheckResourceAttr(resourceName, "processed_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "errors_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccImportCreate(testName string) string {
+	return hclTemplate(`
+		resource "commercelayer_import" "incentro_import" {
+		  attributes {
+			resource_type = "addresses"
+			inputs = jsonencode([
+			  {
+				business     = true
+				company      = "Incentro"
+				line_1       = "Van Nelleweg 1"
+				zip_code     = "3044 BC"
+				country_code = "NL"
+				city         = "Rotterdam"
+				phone        = "+31(0)10 20 20 544"
+				state_code   = "ZH"
+			  }
+			])
+
+			metadata = {
+			  testName: "{{.testName}}"
+			}
+		  }
+		}
+	`, map[string]any{"testName": testName})
+}
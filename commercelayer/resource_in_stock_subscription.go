@@ -0,0 +1,262 @@
+package commercelayer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func resourceInStockSubscription() *schema.Resource {
+	return &schema.Resource{
+		Description: "In-stock subscriptions notify a customer, through the in_stock_subscriptions.notify webhook " +
+			"topic, when a SKU that is out of stock in a market is back in stock. This is mostly useful to provision " +
+			"back-in-stock notification fixtures for demo and test environments.",
+		ReadContext:   resourceInStockSubscriptionReadFunc,
+		CreateContext: resourceInStockSubscriptionCreateFunc,
+		UpdateContext: resourceInStockSubscriptionUpdateFunc,
+		DeleteContext: resourceInStockSubscriptionDeleteFunc,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The in-stock subscription unique identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The resource type",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The subscription status, one of 'active', 'inactive' or 'notified'.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"attributes": {
+				Description: "Resource attributes",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"stock_threshold": {
+							Description:      "The threshold at which to trigger the back in stock notification.",
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+						},
+						"active": {
+							Description: "Indicates if the subscription is active. Inactive subscriptions don't " +
+								"trigger any notification.",
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"reference": {
+							Description: "A string that you can use to add any external identifier to the resource. This " +
+								"can be useful for integrating the resource to an external system, like an ERP, a " +
+								"marketing tool, a CRM, or whatever.",
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"reference_origin": {
+							Description: "Any identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"metadata": {
+							Description: "Set of key-value pairs that you can attach to the resource. This can be useful " +
+								"for storing additional information about the resource in a structured format",
+							Type: schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Optional: true,
+						},
+					},
+				},
+			},
+			"relationships": {
+				Description: "Resource relationships",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				MinItems:    1,
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"market_id": {
+							Description: "The associated market id.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"customer_id": {
+							Description: "The associated customer id.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"sku_id": {
+							Description: "The associated SKU id.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceInStockSubscriptionReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	resp, _, err := c.InStockSubscriptionsApi.
+		GETInStockSubscriptionsInStockSubscriptionId(ctx, d.Id()).Execute()
+	if err != nil {
+		return diagReadErr(d, err)
+	}
+
+	inStockSubscription, ok := resp.GetDataOk()
+	if !ok {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(inStockSubscription.GetId())
+
+	attributes := inStockSubscription.GetAttributes()
+	err = d.Set("status", attributes.GetStatus())
+	if err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
+
+func resourceInStockSubscriptionCreateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	attributes := nestedMap(d.Get("attributes"))
+	relationships := nestedMap(d.Get("relationships"))
+
+	inStockSubscriptionCreate := commercelayer.InStockSubscriptionCreate{
+		Data: commercelayer.InStockSubscriptionCreateData{
+			Type: inStockSubscriptionsType,
+			Attributes: commercelayer.POSTInStockSubscriptions201ResponseDataAttributes{
+				StockThreshold:  intToInt32Ref(attributes["stock_threshold"]),
+				Reference:       stringRef(attributes["reference"]),
+				ReferenceOrigin: stringRef(attributes["reference_origin"]),
+				Metadata:        keyValueRef(attributes["metadata"]),
+			},
+			Relationships: &commercelayer.InStockSubscriptionCreateDataRelationships{
+				Market: commercelayer.BillingInfoValidationRuleCreateDataRelationshipsMarket{
+					Data: commercelayer.AvalaraAccountDataRelationshipsMarketsData{
+						Type: stringRef(marketType),
+						Id:   stringRef(relationships["market_id"]),
+					},
+				},
+				Customer: commercelayer.CouponRecipientCreateDataRelationshipsCustomer{
+					Data: commercelayer.CouponRecipientDataRelationshipsCustomerData{
+						Type: stringRef(customerType),
+						Id:   stringRef(relationships["customer_id"]),
+					},
+				},
+				Sku: commercelayer.InStockSubscriptionCreateDataRelationshipsSku{
+					Data: commercelayer.BundleDataRelationshipsSkusData{
+						Type: stringRef(skusType),
+						Id:   stringRef(relationships["sku_id"]),
+					},
+				},
+			},
+		},
+	}
+
+	err := d.Set("type", inStockSubscriptionsType)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	inStockSubscription, _, err := c.InStockSubscriptionsApi.POSTInStockSubscriptions(ctx).
+		InStockSubscriptionCreate(inStockSubscriptionCreate).Execute()
+	if err != nil {
+		return diagErr(err)
+	}
+
+	d.SetId(*inStockSubscription.Data.Id)
+
+	active, ok := attributes["active"].(bool)
+	if ok && !active {
+		return resourceInStockSubscriptionUpdateFunc(ctx, d, i)
+	}
+
+	return resourceInStockSubscriptionReadFunc(ctx, d, i)
+}
+
+func resourceInStockSubscriptionDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+	_, err := c.InStockSubscriptionsApi.
+		DELETEInStockSubscriptionsInStockSubscriptionId(ctx, d.Id()).Execute()
+	return diag.FromErr(err)
+}
+
+func resourceInStockSubscriptionUpdateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	attributes := nestedMap(d.Get("attributes"))
+	relationships := nestedMap(d.Get("relationships"))
+
+	var inStockSubscriptionUpdate = commercelayer.InStockSubscriptionUpdate{
+		Data: commercelayer.InStockSubscriptionUpdateData{
+			Type: inStockSubscriptionsType,
+			Id:   d.Id(),
+			Attributes: commercelayer.PATCHInStockSubscriptionsInStockSubscriptionId200ResponseDataAttributes{
+				StockThreshold:  intToInt32Ref(attributes["stock_threshold"]),
+				Reference:       stringRef(attributes["reference"]),
+				ReferenceOrigin: stringRef(attributes["reference_origin"]),
+				Metadata:        keyValueRef(attributes["metadata"]),
+			},
+			Relationships: &commercelayer.InStockSubscriptionUpdateDataRelationships{
+				Market: &commercelayer.BillingInfoValidationRuleCreateDataRelationshipsMarket{
+					Data: commercelayer.AvalaraAccountDataRelationshipsMarketsData{
+						Type: stringRef(marketType),
+						Id:   stringRef(relationships["market_id"]),
+					},
+				},
+				Customer: &commercelayer.CouponRecipientCreateDataRelationshipsCustomer{
+					Data: commercelayer.CouponRecipientDataRelationshipsCustomerData{
+						Type: stringRef(customerType),
+						Id:   stringRef(relationships["customer_id"]),
+					},
+				},
+				Sku: &commercelayer.InStockSubscriptionCreateDataRelationshipsSku{
+					Data: commercelayer.BundleDataRelationshipsSkusData{
+						Type: stringRef(skusType),
+						Id:   stringRef(relationships["sku_id"]),
+					},
+				},
+			},
+		},
+	}
+
+	if d.IsNewResource() || d.HasChange("attributes.0.active") {
+		active, ok := attributes["active"].(bool)
+		if !ok || active {
+			inStockSubscriptionUpdate.Data.Attributes.Activate = boolRef(true)
+		} else {
+			inStockSubscriptionUpdate.Data.Attributes.Deactivate = boolRef(true)
+		}
+	}
+
+	_, _, err := c.InStockSubscriptionsApi.
+		PATCHInStockSubscriptionsInStockSubscriptionId(ctx, d.Id()).
+		InStockSubscriptionUpdate(inStockSubscriptionUpdate).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceInStockSubscriptionReadFunc(ctx, d, i)
+}
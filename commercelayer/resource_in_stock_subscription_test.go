@@ -0,0 +1,155 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"github.com/incentro-dc/terraform-provider-commercelayer/client"
+)
+
+func testAccCheckInStockSubscriptionDestroy(s *terraform.State) error {
+	client := testAccProviderCommercelayer.Meta().(*commercelayer.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "commercelayer_in_stock_subscription" {
+			_, resp, err := client.InStockSubscriptionsApi.
+				GETInStockSubscriptionsInStockSubscriptionId(context.Background(), rs.Primary.ID).Execute()
+			if resp.StatusCode == 404 {
+				fmt.Printf("commercelayer_in_stock_subscription with id %s has been removed\n", rs.Primary.ID)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("received response code with status %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+// testAccCustomer creates a customer through the API, as customers are not managed by the provider, and removes it
+// once the test has finished
+func testAccCustomer(s *AcceptanceSuite, email string) string {
+	c := client.New(client.Config{
+		ClientId:     os.Getenv("COMMERCELAYER_CLIENT_ID"),
+		ClientSecret: os.Getenv("COMMERCELAYER_CLIENT_SECRET"),
+		ApiEndpoint:  os.Getenv("COMMERCELAYER_API_ENDPOINT"),
+		AuthEndpoint: os.Getenv("COMMERCELAYER_AUTH_ENDPOINT"),
+	})
+
+	customer, _, err := c.CustomersApi.POSTCustomers(context.Background()).CustomerCreate(commercelayer.CustomerCreate{
+		Data: commercelayer.CustomerCreateData{
+			Type:       customerType,
+			Attributes: commercelayer.POSTCustomers201ResponseDataAttributes{Email: email},
+		},
+	}).Execute()
+	s.Require().NoError(err)
+
+	customerId := customer.Data.GetId()
+	s.T().Cleanup(func() {
+		_, _ = c.CustomersApi.DELETECustomersCustomerId(context.Background(), customerId).Execute()
+	})
+
+	return customerId
+}
+
+func (s *AcceptanceSuite) TestAccInStockSubscription_basic() {
+	resourceName := "commercelayer_in_stock_subscription.incentro_in_stock_subscription"
+
+	testAccPreCheck(s)
+	customerId := testAccCustomer(s, "in-stock@incentro.com")
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckInStockSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccAddressCreate(resourceName),
+					testAccInventoryModelCreate(resourceName),
+					testAccMerchantCreate(resourceName),
+					testAccPriceListCreate(resourceName),
+					testAccExternalTaxCalculatorCreate(resourceName),
+					testAccMarketCreate(resourceName),
+					testAccShippingCategoryCreate(resourceName),
+					testAccSkuCreate(resourceName),
+					testAccInStockSubscriptionCreate(resourceName, customerId)}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", inStockSubscriptionsType),
+					resource.TestCheckResourceAttr(resourceName, "status", "active"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.stock_threshold", "5"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.foo", "bar"),
+				),
+			},
+			{
+				Config: strings.Join([]string{
+					testAccAddressCreate(resourceName),
+					testAccInventoryModelCreate(resourceName),
+					testAccMerchantCreate(resourceName),
+					testAccPriceListCreate(resourceName),
+					testAccExternalTaxCalculatorCreate(resourceName),
+					testAccMarketCreate(resourceName),
+					testAccShippingCategoryCreate(resourceName),
+					testAccSkuCreate(resourceName),
+					testAccInStockSubscriptionUpdate(resourceName, customerId)}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "inactive"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.stock_threshold", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.bar", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInStockSubscriptionCreate(testName string, customerId string) string {
+	return hclTemplate(`
+		resource "commercelayer_in_stock_subscription" "incentro_in_stock_subscription" {
+		  attributes {
+			stock_threshold = 5
+			metadata = {
+			  foo : "bar"
+			  testName: "{{.testName}}"
+			}
+		  }
+
+		  relationships {
+			market_id   = commercelayer_market.incentro_market.id
+			customer_id = "{{.customerId}}"
+			sku_id      = commercelayer_sku.incentro_sku.id
+		  }
+		}
+	`, map[string]any{"testName": testName, "customerId": customerId})
+}
+
+func testAccInStockSubscriptionUpdate(testName string, customerId string) string {
+	return hclTemplate(`
+		resource "commercelayer_in_stock_subscription" "incentro_in_stock_subscription" {
+		  attributes {
+			stock_threshold = 1
+			active          = false
+			metadata = {
+			  bar : "foo"
+			  testName: "{{.testName}}"
+			}
+		  }
+
+		  relationships {
+			market_id   = commercelayer_market.incentro_market.id
+			customer_id = "{{.customerId}}"
+			sku_id      = commercelayer_sku.incentro_sku.id
+		  }
+		}
+	`, map[string]any{"testName": testName, "customerId": customerId})
+}
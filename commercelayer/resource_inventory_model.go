@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
 )
 
@@ -51,10 +52,11 @@ func resourceInventoryModel() *schema.Resource {
 							ValidateDiagFunc: inventoryModelStrategyValidation,
 						},
 						"stock_locations_cutoff": {
-							Description: "The maximum number of stock locations used for inventory computation",
-							Type:        schema.TypeInt,
-							Optional:    true,
-							Default:     2,
+							Description:      "The maximum number of stock locations used for inventory computation",
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          2,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 						},
 						"reference": {
 							Description: "A string that you can use to add any external identifier to the resource. This " +
@@ -89,7 +91,7 @@ func resourceInventoryModelReadFunc(ctx context.Context, d *schema.ResourceData,
 
 	resp, _, err := c.InventoryModelsApi.GETInventoryModelsInventoryModelId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	inventoryModel, ok := resp.GetDataOk()
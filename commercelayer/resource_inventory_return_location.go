@@ -97,7 +97,7 @@ func resourceInventoryReturnLocationReadFunc(ctx context.Context, d *schema.Reso
 
 	resp, _, err := c.InventoryReturnLocationsApi.GETInventoryReturnLocationsInventoryReturnLocationId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	inventoryModel, ok := resp.GetDataOk()
@@ -11,7 +11,9 @@ func resourceInventoryStockLocation() *schema.Resource {
 	return &schema.Resource{
 		Description: "Inventory stock locations build a hierarchy of stock locations within an inventory " +
 			"model, determining the availability of SKU's that are being purchased. In the case a SKU is available " +
-			"in more stock locations, it gets shipped from those with the highest priority.",
+			"in more stock locations, it gets shipped from those with the highest priority. The priority and on_hold " +
+			"flag are refreshed from Commercelayer, so changes made outside of Terraform show up as a diff and are " +
+			"reverted on the next apply.",
 		ReadContext:   resourceInventoryStockLocationReadFunc,
 		CreateContext: resourceInventoryStockLocationCreateFunc,
 		UpdateContext: resourceInventoryStockLocationUpdateFunc,
@@ -105,7 +107,7 @@ func resourceInventoryStockLocationReadFunc(ctx context.Context, d *schema.Resou
 
 	resp, _, err := c.InventoryStockLocationsApi.GETInventoryStockLocationsInventoryStockLocationId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	inventoryModel, ok := resp.GetDataOk()
@@ -116,7 +118,7 @@ func resourceInventoryStockLocationReadFunc(ctx context.Context, d *schema.Resou
 
 	d.SetId(inventoryModel.GetId())
 
-	return nil
+	return diagErr(setInventoryStockLocationPriority(d, inventoryModel.GetAttributes()))
 }
 
 func resourceInventoryStockLocationCreateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -164,7 +166,7 @@ func resourceInventoryStockLocationCreateFunc(ctx context.Context, d *schema.Res
 
 	d.SetId(*inventoryModel.Data.Id)
 
-	return nil
+	return resourceInventoryStockLocationReadFunc(ctx, d, i)
 }
 
 func resourceInventoryStockLocationDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -209,6 +211,20 @@ func resourceInventoryStockLocationUpdateFunc(ctx context.Context, d *schema.Res
 
 	_, _, err := c.InventoryStockLocationsApi.PATCHInventoryStockLocationsInventoryStockLocationId(ctx, d.Id()).
 		InventoryStockLocationUpdate(inventoryModelUpdate).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	return diag.FromErr(err)
+	return resourceInventoryStockLocationReadFunc(ctx, d, i)
+}
+
+// setInventoryStockLocationPriority refreshes the priority and on_hold flag in the attributes block, keeping the
+// other attributes as configured.
+func setInventoryStockLocationPriority(d *schema.ResourceData,
+	attributes commercelayer.GETInventoryStockLocations200ResponseDataInnerAttributes) error {
+	values := nestedMap(d.Get("attributes"))
+	values["priority"] = int(attributes.GetPriority())
+	values["on_hold"] = attributes.GetOnHold()
+
+	return d.Set("attributes", []interface{}{values})
 }
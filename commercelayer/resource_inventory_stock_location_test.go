@@ -3,10 +3,13 @@ package commercelayer
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"github.com/stretchr/testify/assert"
 	"net/http"
 	"strings"
+	"testing"
 )
 
 func testAccCheckInventoryStockLocationDestroy(s *terraform.State) error {
@@ -104,3 +107,25 @@ func testAccInventoryStockLocationUpdate(testName string) string {
 		}
 	`, map[string]any{"testName": testName})
 }
+
+func TestSetInventoryStockLocationPriority(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceInventoryStockLocation().Schema, map[string]interface{}{
+		"attributes": []interface{}{
+			map[string]interface{}{
+				"priority":  1,
+				"on_hold":   false,
+				"reference": "main",
+			},
+		},
+	})
+
+	attributes := commercelayer.GETInventoryStockLocations200ResponseDataInnerAttributes{}
+	attributes.SetPriority(3)
+	attributes.SetOnHold(true)
+
+	err := setInventoryStockLocationPriority(d, attributes)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, d.Get("attributes.0.priority"))
+	assert.Equal(t, true, d.Get("attributes.0.on_hold"))
+	assert.Equal(t, "main", d.Get("attributes.0.reference"))
+}
@@ -14,9 +14,9 @@ func resourceKlarnaGateway() *schema.Resource {
 			"implement a payment flow that supports SCA and 3DS2 by using the Klarna's official " +
 			"JS SDK and libraries.",
 		ReadContext:   resourceKlarnaGatewayReadFunc,
-		CreateContext: resourceKlarnaGatewayCreateFunc,
+		CreateContext: adoptableCreateFunc(klarnaGatewaysType, resourceKlarnaGatewayCreateFunc, resourceKlarnaGatewayUpdateFunc),
 		UpdateContext: resourceKlarnaGatewayUpdateFunc,
-		DeleteContext: resourceKlarnaGatewayDeleteFunc,
+		DeleteContext: retainableDeleteFunc(resourceKlarnaGatewayDeleteFunc),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -31,6 +31,7 @@ func resourceKlarnaGateway() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"retain_on_destroy": retainOnDestroySchema(),
 			"attributes": {
 				Description: "Resource attributes",
 				Type:        schema.TypeList,
@@ -45,9 +46,12 @@ func resourceKlarnaGateway() *schema.Resource {
 							Required:    true,
 						},
 						"country_code": {
-							Description: "The gateway country code one of EU, US, or OC.",
-							Type:        schema.TypeString,
-							Required:    true,
+							Description: "The Klarna region of the account, one of EU, US, or OC. This selects the " +
+								"Klarna API endpoint the gateway talks to, so accounts of different regions " +
+								"each need their own gateway.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: klarnaRegionValidation,
 						},
 						"api_key": {
 							Description: "The public key linked to your API credential.",
@@ -92,7 +96,7 @@ func resourceKlarnaGatewayReadFunc(ctx context.Context, d *schema.ResourceData,
 
 	resp, _, err := c.KlarnaGatewaysApi.GETKlarnaGatewaysKlarnaGatewayId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	klarnaGateway, ok := resp.GetDataOk()
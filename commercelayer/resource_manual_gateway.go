@@ -12,9 +12,9 @@ func resourceManualGateway() *schema.Resource {
 		Description: "An manual payment defines a list of stock locations ordered by priority. The priority and " +
 			"cutoff determine how the availability of SKU's gets calculated within a market.",
 		ReadContext:   resourceManualGatewayReadFunc,
-		CreateContext: resourceManualGatewayCreateFunc,
+		CreateContext: adoptableCreateFunc(manualGatewaysType, resourceManualGatewayCreateFunc, resourceManualGatewayUpdateFunc),
 		UpdateContext: resourceManualGatewayUpdateFunc,
-		DeleteContext: resourceManualGatewayDeleteFunc,
+		DeleteContext: retainableDeleteFunc(resourceManualGatewayDeleteFunc),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -29,6 +29,7 @@ func resourceManualGateway() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"retain_on_destroy": retainOnDestroySchema(),
 			"attributes": {
 				Description: "Resource attributes",
 				Type:        schema.TypeList,
@@ -75,7 +76,7 @@ func resourceManualGatewayReadFunc(ctx context.Context, d *schema.ResourceData,
 
 	resp, _, err := c.ManualGatewaysApi.GETManualGatewaysManualGatewayId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	manualGateway, ok := resp.GetDataOk()
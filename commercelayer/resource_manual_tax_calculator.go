@@ -2,15 +2,18 @@ package commercelayer
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"strconv"
 )
 
 func resourceManualTaxCalculator() *schema.Resource {
 	return &schema.Resource{
 		Description: "Configure the manual tax calculator by creating one or more associated tax rules. " +
-			"The rules will apply the related tax rate to the matching orders.",
+			"The rules will apply the related tax rate to the matching orders. The tax rules can be defined inline " +
+			"as tax_rule blocks, they are created, updated and deleted along with the calculator by their position.",
 		ReadContext:   resourceManualTaxCalculatorReadFunc,
 		CreateContext: resourceManualTaxCalculatorCreateFunc,
 		UpdateContext: resourceManualTaxCalculatorUpdateFunc,
@@ -66,6 +69,114 @@ func resourceManualTaxCalculator() *schema.Resource {
 					},
 				},
 			},
+			"tax_rule": {
+				Description: "The tax rules of the calculator, in order.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The tax rule unique identifier",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The tax rule internal name.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"tax_rate": {
+							Description: "The tax rate for this rule, e.g. 0.22 for 22%.",
+							Type:        schema.TypeFloat,
+							Required:    true,
+						},
+						"country_code_regex": {
+							Description:      "The regex that will be evaluated to match the shipping address country code.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: countryCodeRegexValidation,
+						},
+						"not_country_code_regex": {
+							Description: "The regex that will be evaluated as negative match for the shipping " +
+								"address country code.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: regexValidation,
+						},
+						"state_code_regex": {
+							Description:      "The regex that will be evaluated to match the shipping address state code.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: regexValidation,
+						},
+						"not_state_code_regex": {
+							Description: "The regex that will be evaluated as negative match for the shipping " +
+								"address state code.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: regexValidation,
+						},
+						"zip_code_regex": {
+							Description:      "The regex that will be evaluated to match the shipping address zip code.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: regexValidation,
+						},
+						"not_zip_code_regex": {
+							Description: "The regex that will be evaluated as negative match for the shipping " +
+								"address zip code.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: regexValidation,
+						},
+						"freight_taxable": {
+							Description: "Indicates if the freight is taxable.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+						"payment_method_taxable": {
+							Description: "Indicates if the payment method is taxable.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+						"gift_card_taxable": {
+							Description: "Indicates if gift cards are taxable.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+						"adjustment_taxable": {
+							Description: "Indicates if adjustments are taxable.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+						"reference": {
+							Description: "A string that you can use to add any external identifier to the resource. This " +
+								"can be useful for integrating the resource to an external system, like an ERP, a " +
+								"marketing tool, a CRM, or whatever.",
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"reference_origin": {
+							Description: "Any identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"metadata": {
+							Description: "Set of key-value pairs that you can attach to the resource. This can be useful " +
+								"for storing additional information about the resource in a structured format",
+							Type: schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -75,7 +186,7 @@ func resourceManualTaxCalculatorReadFunc(ctx context.Context, d *schema.Resource
 
 	resp, _, err := c.ManualTaxCalculatorsApi.GETManualTaxCalculatorsManualTaxCalculatorId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	manualTaxCalculator, ok := resp.GetDataOk()
@@ -86,9 +197,81 @@ func resourceManualTaxCalculatorReadFunc(ctx context.Context, d *schema.Resource
 
 	d.SetId(manualTaxCalculator.GetId())
 
+	rules, err := resourceManualTaxCalculatorReadTaxRules(ctx, c, d)
+	if err != nil {
+		return diagOptionalErr(err, "reading the tax rules")
+	}
+
+	err = d.Set("tax_rule", rules)
+	if err != nil {
+		return diagErr(err)
+	}
+
 	return nil
 }
 
+// resourceManualTaxCalculatorReadTaxRules reads the tax rules of the calculator back in the order of the state. Rules
+// deleted outside of Terraform are dropped, so that they are created again, and rules added outside of Terraform are
+// appended, so that they are deleted again.
+func resourceManualTaxCalculatorReadTaxRules(ctx context.Context, c *commercelayer.APIClient, d *schema.ResourceData) ([]interface{}, error) {
+	resources, err := listResources(ctx, c, fmt.Sprintf("/manual_tax_calculators/%s/tax_rules", d.Id()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ruleIds []string
+	rulesById := map[string]map[string]interface{}{}
+	for _, resource := range resources {
+		var attributes commercelayer.GETTaxRules200ResponseDataInnerAttributes
+		err = resource.decodeAttributes(&attributes)
+		if err != nil {
+			return nil, err
+		}
+		ruleIds = append(ruleIds, resource.Id)
+		rulesById[resource.Id] = flattenTaxRule(resource.Id, attributes)
+	}
+
+	var rules []interface{}
+	for _, r := range d.Get("tax_rule").([]interface{}) {
+		ruleId, _ := r.(map[string]interface{})["id"].(string)
+		if rule, ok := rulesById[ruleId]; ok {
+			rules = append(rules, rule)
+			delete(rulesById, ruleId)
+		}
+	}
+	for _, ruleId := range ruleIds {
+		if rule, ok := rulesById[ruleId]; ok {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules, nil
+}
+
+func flattenTaxRule(id string, attributes commercelayer.GETTaxRules200ResponseDataInnerAttributes) map[string]interface{} {
+	// the SDK decodes the rate as a float32, formatting it with the float32 precision restores e.g. 0.21 exactly
+	taxRate, _ := strconv.ParseFloat(strconv.FormatFloat(float64(attributes.GetTaxRate()), 'f', -1, 32), 64)
+
+	return map[string]interface{}{
+		"id":                     id,
+		"name":                   attributes.GetName(),
+		"tax_rate":               taxRate,
+		"country_code_regex":     attributes.GetCountryCodeRegex(),
+		"not_country_code_regex": attributes.GetNotCountryCodeRegex(),
+		"state_code_regex":       attributes.GetStateCodeRegex(),
+		"not_state_code_regex":   attributes.GetNotStateCodeRegex(),
+		"zip_code_regex":         attributes.GetZipCodeRegex(),
+		"not_zip_code_regex":     attributes.GetNotZipCodeRegex(),
+		"freight_taxable":        attributes.GetFreightTaxable(),
+		"payment_method_taxable": attributes.GetPaymentMethodTaxable(),
+		"gift_card_taxable":      attributes.GetGiftCardTaxable(),
+		"adjustment_taxable":     attributes.GetAdjustmentTaxable(),
+		"reference":              attributes.GetReference(),
+		"reference_origin":       attributes.GetReferenceOrigin(),
+		"metadata":               stringMap(attributes.GetMetadata()),
+	}
+}
+
 func resourceManualTaxCalculatorCreateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
 	c := i.(*commercelayer.APIClient)
 
@@ -118,11 +301,23 @@ func resourceManualTaxCalculatorCreateFunc(ctx context.Context, d *schema.Resour
 
 	d.SetId(*manualTaxCalculator.Data.Id)
 
-	return nil
+	return diagErr(resourceManualTaxCalculatorSyncTaxRules(ctx, c, d))
 }
 
 func resourceManualTaxCalculatorDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
 	c := i.(*commercelayer.APIClient)
+
+	for _, rule := range d.Get("tax_rule").([]interface{}) {
+		ruleId, _ := rule.(map[string]interface{})["id"].(string)
+		if ruleId == "" {
+			continue
+		}
+		_, err := c.TaxRulesApi.DELETETaxRulesTaxRuleId(ctx, ruleId).Execute()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	_, err := c.ManualTaxCalculatorsApi.DELETEManualTaxCalculatorsManualTaxCalculatorId(ctx, d.Id()).Execute()
 	return diag.FromErr(err)
 }
@@ -147,6 +342,136 @@ func resourceManualTaxCalculatorUpdateFunc(ctx context.Context, d *schema.Resour
 
 	_, _, err := c.ManualTaxCalculatorsApi.PATCHManualTaxCalculatorsManualTaxCalculatorId(ctx, d.Id()).
 		ManualTaxCalculatorUpdate(manualTaxCalculatorUpdate).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	return diag.FromErr(err)
+	return diag.FromErr(resourceManualTaxCalculatorSyncTaxRules(ctx, c, d))
+}
+
+// resourceManualTaxCalculatorSyncTaxRules reconciles the inline tax rules by position: rules that already exist at
+// a position are updated when changed, additional rules are created and rules that have been removed are deleted.
+// When a request fails, the rules synced so far are kept in the state along with the old rules that have not been
+// touched yet, so that rules created before the failure are not orphaned.
+func resourceManualTaxCalculatorSyncTaxRules(ctx context.Context, c *commercelayer.APIClient, d *schema.ResourceData) error {
+	oldRules, newRules := d.GetChange("tax_rule")
+	oldList := oldRules.([]interface{})
+	newList := newRules.([]interface{})
+
+	manualTaxCalculator := &commercelayer.TaxRuleCreateDataRelationshipsManualTaxCalculator{
+		Data: commercelayer.TaxRuleDataRelationshipsManualTaxCalculatorData{
+			Type: stringRef(manualTaxCalculatorsType),
+			Id:   stringRef(d.Id()),
+		},
+	}
+
+	var rules []interface{}
+	failed := func(idx int, err error) error {
+		if idx < len(oldList) {
+			rules = append(rules, oldList[idx:]...)
+		}
+		_ = d.Set("tax_rule", rules)
+		return err
+	}
+
+	for idx, r := range newList {
+		rule := r.(map[string]interface{})
+
+		var ruleId string
+		if idx < len(oldList) {
+			ruleId, _ = oldList[idx].(map[string]interface{})["id"].(string)
+		}
+
+		if ruleId == "" {
+			taxRuleCreate := commercelayer.TaxRuleCreate{
+				Data: commercelayer.TaxRuleCreateData{
+					Type:       taxRulesType,
+					Attributes: taxRuleCreateAttributes(rule),
+					Relationships: &commercelayer.TaxRuleCreateDataRelationships{
+						ManualTaxCalculator: *manualTaxCalculator,
+					},
+				},
+			}
+			resp, _, err := c.TaxRulesApi.POSTTaxRules(ctx).TaxRuleCreate(taxRuleCreate).Execute()
+			if err != nil {
+				return failed(idx, fmt.Errorf("failed to create tax rule %d: %w", idx, err))
+			}
+			ruleId = resp.Data.GetId()
+		} else if d.HasChange(fmt.Sprintf("tax_rule.%d", idx)) {
+			taxRuleUpdate := commercelayer.TaxRuleUpdate{
+				Data: commercelayer.TaxRuleUpdateData{
+					Type:       taxRulesType,
+					Id:         ruleId,
+					Attributes: taxRuleUpdateAttributes(rule),
+					Relationships: &commercelayer.TaxRuleUpdateDataRelationships{
+						ManualTaxCalculator: manualTaxCalculator,
+					},
+				},
+			}
+			_, _, err := c.TaxRulesApi.PATCHTaxRulesTaxRuleId(ctx, ruleId).TaxRuleUpdate(taxRuleUpdate).Execute()
+			if err != nil {
+				return failed(idx, fmt.Errorf("failed to update tax rule %d: %w", idx, err))
+			}
+		}
+
+		rule["id"] = ruleId
+		rules = append(rules, rule)
+	}
+
+	for idx := len(newList); idx < len(oldList); idx++ {
+		ruleId, _ := oldList[idx].(map[string]interface{})["id"].(string)
+		if ruleId == "" {
+			continue
+		}
+		_, err := c.TaxRulesApi.DELETETaxRulesTaxRuleId(ctx, ruleId).Execute()
+		if err != nil {
+			return failed(idx, fmt.Errorf("failed to delete tax rule %d: %w", idx, err))
+		}
+	}
+
+	return d.Set("tax_rule", rules)
+}
+
+func taxRuleCreateAttributes(rule map[string]interface{}) commercelayer.POSTTaxRules201ResponseDataAttributes {
+	taxRate := float32(rule["tax_rate"].(float64))
+
+	return commercelayer.POSTTaxRules201ResponseDataAttributes{
+		Name:                 rule["name"].(string),
+		TaxRate:              &taxRate,
+		CountryCodeRegex:     stringRef(rule["country_code_regex"]),
+		NotCountryCodeRegex:  stringRef(rule["not_country_code_regex"]),
+		StateCodeRegex:       stringRef(rule["state_code_regex"]),
+		NotStateCodeRegex:    stringRef(rule["not_state_code_regex"]),
+		ZipCodeRegex:         stringRef(rule["zip_code_regex"]),
+		NotZipCodeRegex:      stringRef(rule["not_zip_code_regex"]),
+		FreightTaxable:       boolRef(rule["freight_taxable"]),
+		PaymentMethodTaxable: boolRef(rule["payment_method_taxable"]),
+		GiftCardTaxable:      boolRef(rule["gift_card_taxable"]),
+		AdjustmentTaxable:    boolRef(rule["adjustment_taxable"]),
+		Reference:            stringRef(rule["reference"]),
+		ReferenceOrigin:      stringRef(rule["reference_origin"]),
+		Metadata:             keyValueRef(rule["metadata"]),
+	}
+}
+
+func taxRuleUpdateAttributes(rule map[string]interface{}) commercelayer.PATCHTaxRulesTaxRuleId200ResponseDataAttributes {
+	taxRate := float32(rule["tax_rate"].(float64))
+
+	return commercelayer.PATCHTaxRulesTaxRuleId200ResponseDataAttributes{
+		Name:                 stringRef(rule["name"]),
+		TaxRate:              &taxRate,
+		CountryCodeRegex:     stringRef(rule["country_code_regex"]),
+		NotCountryCodeRegex:  stringRef(rule["not_country_code_regex"]),
+		StateCodeRegex:       stringRef(rule["state_code_regex"]),
+		NotStateCodeRegex:    stringRef(rule["not_state_code_regex"]),
+		ZipCodeRegex:         stringRef(rule["zip_code_regex"]),
+		NotZipCodeRegex:      stringRef(rule["not_zip_code_regex"]),
+		FreightTaxable:       boolRef(rule["freight_taxable"]),
+		PaymentMethodTaxable: boolRef(rule["payment_method_taxable"]),
+		GiftCardTaxable:      boolRef(rule["gift_card_taxable"]),
+		AdjustmentTaxable:    boolRef(rule["adjustment_taxable"]),
+		Reference:            stringRef(rule["reference"]),
+		ReferenceOrigin:      stringRef(rule["reference_origin"]),
+		Metadata:             keyValueRef(rule["metadata"]),
+	}
 }
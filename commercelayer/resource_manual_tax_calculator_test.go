@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
 )
 
 func testAccCheckManualTaxCalculatorDestroy(s *terraform.State) error {
@@ -86,3 +90,164 @@ func testAccManualTaxCalculatorUpdate(testName string) string {
 	}
 `, map[string]any{"testName": testName})
 }
+
+func (s *AcceptanceSuite) TestAccManualTaxCalculator_taxRules() {
+	resourceName := "commercelayer_manual_tax_calculator.incentro_tax_rules"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckManualTaxCalculatorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccManualTaxCalculatorTaxRulesCreate(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tax_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tax_rule.0.tax_rate", "0.21"),
+					resource.TestCheckResourceAttr(resourceName, "tax_rule.0.freight_taxable", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "tax_rule.0.id"),
+				),
+			},
+			{
+				Config: testAccManualTaxCalculatorTaxRulesUpdate(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tax_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tax_rule.0.name", "NL low"),
+					resource.TestCheckResourceAttr(resourceName, "tax_rule.0.tax_rate", "0.09"),
+					resource.TestCheckResourceAttr(resourceName, "tax_rule.1.country_code_regex", "^BE$"),
+					resource.TestCheckResourceAttrSet(resourceName, "tax_rule.1.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccManualTaxCalculatorTaxRulesCreate(testName string) string {
+	return hclTemplate(`
+		resource "commercelayer_manual_tax_calculator" "incentro_tax_rules" {
+           attributes {
+			name                   = "Incentro Tax Rules"
+			metadata = {
+				testName: "{{.testName}}"
+    		}
+  		}
+
+		   tax_rule {
+			name               = "NL high"
+			tax_rate           = 0.21
+			country_code_regex = "^NL$"
+			freight_taxable    = true
+		   }
+	}
+`, map[string]any{"testName": testName})
+}
+
+func testAccManualTaxCalculatorTaxRulesUpdate(testName string) string {
+	return hclTemplate(`
+		resource "commercelayer_manual_tax_calculator" "incentro_tax_rules" {
+           attributes {
+			name                   = "Incentro Tax Rules"
+			metadata = {
+				testName: "{{.testName}}"
+    		}
+  		}
+
+		   tax_rule {
+			name               = "NL low"
+			tax_rate           = 0.09
+			country_code_regex = "^NL$"
+		   }
+
+		   tax_rule {
+			name               = "BE high"
+			tax_rate           = 0.21
+			country_code_regex = "^BE$"
+			freight_taxable    = true
+		   }
+	}
+`, map[string]any{"testName": testName})
+}
+
+func TestResourceManualTaxCalculatorReadTaxRules(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/manual_tax_calculators/calculator/tax_rules", r.URL.Path)
+		_, _ = fmt.Fprint(w, `{
+			"data": [
+				{"id": "rule-3", "type": "tax_rules", "attributes": {"name": "BE", "tax_rate": 0.21}},
+				{"id": "rule-4", "type": "tax_rules", "attributes": {"name": "DE", "tax_rate": 0.19}},
+				{"id": "rule-1", "type": "tax_rules", "attributes": {"name": "NL", "tax_rate": 0.09, "freight_taxable": true}}
+			],
+			"meta": {"record_count": 3, "page_count": 1}
+		}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceManualTaxCalculator().Schema, map[string]interface{}{
+		"tax_rule": []interface{}{
+			map[string]interface{}{"name": "NL", "tax_rate": 0.09},
+			map[string]interface{}{"name": "FR", "tax_rate": 0.2},
+			map[string]interface{}{"name": "BE", "tax_rate": 0.21},
+		},
+	})
+	d.SetId("calculator")
+	assert.NoError(t, d.Set("tax_rule", []interface{}{
+		map[string]interface{}{"id": "rule-1", "name": "NL", "tax_rate": 0.09},
+		map[string]interface{}{"id": "rule-2", "name": "FR", "tax_rate": 0.2},
+		map[string]interface{}{"id": "rule-3", "name": "BE", "tax_rate": 0.21},
+	}))
+
+	rules, err := resourceManualTaxCalculatorReadTaxRules(context.Background(), c, d)
+	assert.NoError(t, err)
+	assert.Len(t, rules, 3)
+
+	var ids []string
+	for _, rule := range rules {
+		ids = append(ids, rule.(map[string]interface{})["id"].(string))
+	}
+	assert.Equal(t, []string{"rule-1", "rule-3", "rule-4"}, ids)
+	assert.Equal(t, 0.21, rules[1].(map[string]interface{})["tax_rate"])
+	assert.Equal(t, true, rules[0].(map[string]interface{})["freight_taxable"])
+}
+
+func TestResourceManualTaxCalculatorSyncTaxRulesKeepsCreatedRules(t *testing.T) {
+	var requests int
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/tax_rules", r.URL.Path)
+		if requests > 1 {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = fmt.Fprint(w, `{"errors": [{"title": "is invalid"}]}`)
+			return
+		}
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `{"data": {"id": "rule-1", "type": "tax_rules", "attributes": {"name": "NL"}}}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceManualTaxCalculator().Schema, map[string]interface{}{
+		"tax_rule": []interface{}{
+			map[string]interface{}{"name": "NL", "tax_rate": 0.09},
+			map[string]interface{}{"name": "BE", "tax_rate": 0.21},
+		},
+	})
+	d.SetId("calculator")
+
+	err := resourceManualTaxCalculatorSyncTaxRules(context.Background(), c, d)
+	assert.Error(t, err)
+
+	rules := d.Get("tax_rule").([]interface{})
+	assert.Len(t, rules, 1)
+	assert.Equal(t, "rule-1", rules[0].(map[string]interface{})["id"])
+}
+
+func TestTaxRuleCreateAttributesZeroRate(t *testing.T) {
+	attributes := taxRuleCreateAttributes(map[string]interface{}{
+		"name":     "Zero rated",
+		"tax_rate": 0.0,
+	})
+
+	assert.Equal(t, "Zero rated", attributes.Name)
+	assert.NotNil(t, attributes.TaxRate)
+	assert.Equal(t, float32(0), *attributes.TaxRate)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
 )
 
@@ -29,6 +30,18 @@ func resourceMarket() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"shared_secret": {
+				Description: "The shared secret used to sign the external requests payload, e.g. to the " +
+					"external_prices_url and external_order_validation_url.",
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"disabled_at": {
+				Description: "Time at which the market was disabled, empty while the market is enabled.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"attributes": {
 				Description: "Resource attributes",
 				Type:        schema.TypeList,
@@ -53,14 +66,23 @@ func resourceMarket() *schema.Resource {
 							Optional:    true,
 						},
 						"external_prices_url": {
-							Description: "The URL used to fetch prices from an external source",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Description:      "The URL used to fetch prices from an external source",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
 						},
 						"external_order_validation_url": {
-							Description: "The URL used to validate orders by an external source.",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Description:      "The URL used to validate orders by an external source.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+						},
+						"enabled": {
+							Description: "Indicates if the market is enabled. Disabling a market takes it offline, " +
+								"orders can not be placed in it until it is enabled again.",
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
 						},
 						"reference": {
 							Description: "A string that you can use to add any external identifier to the resource. This " +
@@ -131,7 +153,7 @@ func resourceMarketReadFunc(ctx context.Context, d *schema.ResourceData, i inter
 
 	resp, _, err := c.MarketsApi.GETMarketsMarketId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	Market, ok := resp.GetDataOk()
@@ -142,6 +164,18 @@ func resourceMarketReadFunc(ctx context.Context, d *schema.ResourceData, i inter
 
 	d.SetId(Market.GetId())
 
+	attributes := Market.GetAttributes()
+	values := map[string]interface{}{
+		"shared_secret": attributes.GetSharedSecret(),
+		"disabled_at":   attributes.GetDisabledAt(),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
 	return nil
 }
 
@@ -217,7 +251,11 @@ func resourceMarketCreateFunc(ctx context.Context, d *schema.ResourceData, i int
 
 	d.SetId(*market.Data.Id)
 
-	return nil
+	if !attributes["enabled"].(bool) {
+		return resourceMarketUpdateFunc(ctx, d, i)
+	}
+
+	return resourceMarketReadFunc(ctx, d, i)
 }
 
 func resourceMarketDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -287,7 +325,18 @@ func resourceMarketUpdateFunc(ctx context.Context, d *schema.ResourceData, i int
 			}}
 	}
 
+	if d.IsNewResource() || d.HasChange("attributes.0.enabled") {
+		if attributes["enabled"].(bool) {
+			marketUpdate.Data.Attributes.Enable = boolRef(true)
+		} else {
+			marketUpdate.Data.Attributes.Disable = boolRef(true)
+		}
+	}
+
 	_, _, err := c.MarketsApi.PATCHMarketsMarketId(ctx, d.Id()).MarketUpdate(marketUpdate).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	return diag.FromErr(err)
+	return resourceMarketReadFunc(ctx, d, i)
 }
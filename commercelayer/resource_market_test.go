@@ -65,6 +65,35 @@ func (s *AcceptanceSuite) TestAccMarket_basic() {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.name", "Incentro Market Changed"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.facebook_pixel_id", "pixelchanged"),
+					resource.TestCheckResourceAttrSet(resourceName, "shared_secret"),
+				),
+			},
+			{
+				Config: strings.Join([]string{
+					testAccAddressCreate(resourceName),
+					testAccInventoryModelCreate(resourceName),
+					testAccMerchantCreate(resourceName),
+					testAccPriceListCreate(resourceName),
+					testAccExternalTaxCalculatorCreate(resourceName),
+					testAccMarketDisable(resourceName)}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "disabled_at"),
+				),
+			},
+			{
+				Config: strings.Join([]string{
+					testAccAddressCreate(resourceName),
+					testAccInventoryModelCreate(resourceName),
+					testAccMerchantCreate(resourceName),
+					testAccPriceListCreate(resourceName),
+					testAccExternalTaxCalculatorCreate(resourceName),
+					testAccMarketUpdate(resourceName)}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "disabled_at", ""),
 				),
 			},
 		},
@@ -114,3 +143,26 @@ func testAccMarketUpdate(testName string) string {
 		}
 	`, map[string]any{"testName": testName})
 }
+
+func testAccMarketDisable(testName string) string {
+	return hclTemplate(`
+		resource "commercelayer_market" "incentro_market" {
+		  attributes {
+			name              = "Incentro Market Changed"
+			facebook_pixel_id = "pixelchanged"
+            external_order_validation_url = "https://www.example.com"
+			enabled           = false
+
+			metadata = {
+			  testName: "{{.testName}}"
+			}
+		  }
+		
+		  relationships {
+			inventory_model_id = commercelayer_inventory_model.incentro_inventory_model.id
+			merchant_id        = commercelayer_merchant.incentro_merchant.id
+			price_list_id      = commercelayer_price_list.incentro_price_list.id
+		  }
+		}
+	`, map[string]any{"testName": testName})
+}
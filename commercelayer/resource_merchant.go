@@ -2,6 +2,7 @@ package commercelayer
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
@@ -66,18 +67,35 @@ func resourceMerchant() *schema.Resource {
 					},
 				},
 			},
+			"address": {
+				Description: "The business address of the merchant. The address is created, updated and destroyed " +
+					"together with the merchant, as an alternative to an address_id relationship. An imported merchant reads " +
+					"its address into this block.",
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: []string{"address", "relationships.0.address_id"},
+				Elem: &schema.Resource{
+					Schema: addressAttributesSchema(),
+				},
+			},
+			"address_id": {
+				Description: "The id of the merchant address, either the associated or the inline address.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"relationships": {
 				Description: "Resource relationships",
 				Type:        schema.TypeList,
 				MaxItems:    1,
-				MinItems:    1,
-				Required:    true,
+				Optional:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"address_id": {
-							Description: "The associated address id.",
-							Type:        schema.TypeString,
-							Required:    true,
+							Description:  "The associated address id.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"address", "relationships.0.address_id"},
 						},
 					},
 				},
@@ -91,7 +109,7 @@ func resourceMerchantReadFunc(ctx context.Context, d *schema.ResourceData, i int
 
 	resp, _, err := c.MerchantsApi.GETMerchantsMerchantId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	merchant, ok := resp.GetDataOk()
@@ -102,14 +120,18 @@ func resourceMerchantReadFunc(ctx context.Context, d *schema.ResourceData, i int
 
 	d.SetId(merchant.GetId())
 
-	return nil
+	return diagErr(readInlineAddress(ctx, c, d, fmt.Sprintf("/merchants/%s/address", merchant.GetId())))
 }
 
 func resourceMerchantCreateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
 	c := i.(*commercelayer.APIClient)
 
 	attributes := nestedMap(d.Get("attributes"))
-	relationships := nestedMap(d.Get("relationships"))
+
+	addressId, err := syncInlineAddress(ctx, c, d)
+	if err != nil {
+		return diagErr(err)
+	}
 
 	merchantCreate := commercelayer.MerchantCreate{
 		Data: commercelayer.MerchantCreateData{
@@ -124,20 +146,21 @@ func resourceMerchantCreateFunc(ctx context.Context, d *schema.ResourceData, i i
 				Address: commercelayer.CustomerAddressCreateDataRelationshipsAddress{
 					Data: commercelayer.BingGeocoderDataRelationshipsAddressesData{
 						Type: stringRef(addressType),
-						Id:   stringRef(relationships["address_id"]),
+						Id:   stringRef(addressId),
 					},
 				},
 			},
 		},
 	}
 
-	err := d.Set("type", merchantType)
+	err = d.Set("type", merchantType)
 	if err != nil {
 		return diagErr(err)
 	}
 
 	merchant, _, err := c.MerchantsApi.POSTMerchants(ctx).MerchantCreate(merchantCreate).Execute()
 	if err != nil {
+		discardInlineAddress(ctx, c, d, "", addressId)
 		return diagErr(err)
 	}
 
@@ -149,6 +172,14 @@ func resourceMerchantCreateFunc(ctx context.Context, d *schema.ResourceData, i i
 func resourceMerchantDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
 	c := i.(*commercelayer.APIClient)
 	_, err := c.MerchantsApi.DELETEMerchantsMerchantId(ctx, d.Id()).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(d.Get("address").([]any)) > 0 {
+		_, err = c.AddressesApi.DELETEAddressesAddressId(ctx, d.Get("address_id").(string)).Execute()
+	}
+
 	return diag.FromErr(err)
 }
 
@@ -156,7 +187,15 @@ func resourceMerchantUpdateFunc(ctx context.Context, d *schema.ResourceData, i i
 	c := i.(*commercelayer.APIClient)
 
 	attributes := nestedMap(d.Get("attributes"))
-	relationships := nestedMap(d.Get("relationships"))
+
+	// The previous address is only owned by the merchant when it was defined inline
+	oldAddress, _ := d.GetChange("address")
+	oldAddressId := d.Get("address_id").(string)
+
+	addressId, err := syncInlineAddress(ctx, c, d)
+	if err != nil {
+		return diagErr(err)
+	}
 
 	var merchantUpdate = commercelayer.MerchantUpdate{
 		Data: commercelayer.MerchantUpdateData{
@@ -172,14 +211,22 @@ func resourceMerchantUpdateFunc(ctx context.Context, d *schema.ResourceData, i i
 				Address: &commercelayer.CustomerAddressCreateDataRelationshipsAddress{
 					Data: commercelayer.BingGeocoderDataRelationshipsAddressesData{
 						Type: stringRef(addressType),
-						Id:   stringRef(relationships["address_id"]),
+						Id:   stringRef(addressId),
 					},
 				},
 			},
 		},
 	}
 
-	_, _, err := c.MerchantsApi.PATCHMerchantsMerchantId(ctx, d.Id()).MerchantUpdate(merchantUpdate).Execute()
+	_, _, err = c.MerchantsApi.PATCHMerchantsMerchantId(ctx, d.Id()).MerchantUpdate(merchantUpdate).Execute()
+	if err != nil {
+		discardInlineAddress(ctx, c, d, oldAddressId, addressId)
+		return diagErr(err)
+	}
+
+	if len(oldAddress.([]any)) > 0 && oldAddressId != addressId {
+		_, err = c.AddressesApi.DELETEAddressesAddressId(ctx, oldAddressId).Execute()
+	}
 
 	return diag.FromErr(err)
 }
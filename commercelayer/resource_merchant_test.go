@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"github.com/stretchr/testify/assert"
+	"net/http"
 	"strings"
+	"testing"
 )
 
 func testAccCheckMerchantDestroy(s *terraform.State) error {
@@ -107,3 +111,110 @@ func testAccMerchantUpdate(testName string) string {
 		}
 	`, map[string]any{"testName": testName})
 }
+
+func TestMerchantAddressExactlyOneOf(t *testing.T) {
+	address := []any{map[string]any{
+		"line_1":       "Van Nelleweg 1",
+		"city":         "Rotterdam",
+		"state_code":   "ZH",
+		"country_code": "NL",
+		"phone":        "+31(0)10 20 20 544",
+	}}
+	relationships := []any{map[string]any{
+		"address_id": "address",
+	}}
+	attributes := []any{map[string]any{
+		"name": "Incentro Merchant",
+	}}
+
+	diags := resourceMerchant().Validate(terraform.NewResourceConfigRaw(map[string]any{
+		"attributes": attributes,
+		"address":    address,
+	}))
+	assert.False(t, diags.HasError())
+
+	diags = resourceMerchant().Validate(terraform.NewResourceConfigRaw(map[string]any{
+		"attributes":    attributes,
+		"relationships": relationships,
+	}))
+	assert.False(t, diags.HasError())
+
+	diags = resourceMerchant().Validate(terraform.NewResourceConfigRaw(map[string]any{
+		"attributes":    attributes,
+		"address":       address,
+		"relationships": relationships,
+	}))
+	assert.True(t, diags.HasError())
+
+	diags = resourceMerchant().Validate(terraform.NewResourceConfigRaw(map[string]any{
+		"attributes": attributes,
+	}))
+	assert.True(t, diags.HasError())
+}
+
+func TestResourceMerchantCreateDiscardsInlineAddress(t *testing.T) {
+	var deleted []string
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /addresses":
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprint(w, `{"data": {"id": "address", "type": "addresses", "attributes": {"line_1": "Van Nelleweg 1"}}}`)
+		case "POST /merchants":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = fmt.Fprint(w, `{"errors": [{"title": "is invalid"}]}`)
+		case "DELETE /addresses/address":
+			deleted = append(deleted, "address")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceMerchant().Schema, map[string]interface{}{
+		"attributes": []interface{}{map[string]interface{}{
+			"name": "Incentro Merchant",
+		}},
+		"address": []interface{}{map[string]interface{}{
+			"line_1":       "Van Nelleweg 1",
+			"city":         "Rotterdam",
+			"state_code":   "ZH",
+			"country_code": "NL",
+			"phone":        "+31(0)10 20 20 544",
+		}},
+	})
+
+	diags := resourceMerchantCreateFunc(context.Background(), d, c)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, []string{"address"}, deleted)
+}
+
+func TestResourceMerchantReadInlineAddress(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch r.URL.Path {
+		case "/merchants/merchant":
+			_, _ = fmt.Fprint(w, `{"data": {"id": "merchant", "type": "merchants", "attributes": {"name": "Incentro Merchant"}}}`)
+		case "/merchants/merchant/address":
+			_, _ = fmt.Fprint(w, `{"data": {"id": "address", "type": "addresses", "attributes": {
+				"line_1": "Van Nelleweg 1", "city": "Rotterdam", "state_code": "ZH", "country_code": "NL",
+				"phone": "+31(0)10 20 20 544", "lat": 51.9225, "lng": 4.4792, "metadata": {"foo": "bar"}
+			}}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	// An imported merchant has neither an address block nor an address_id relationship
+	d := schema.TestResourceDataRaw(t, resourceMerchant().Schema, map[string]interface{}{})
+	d.SetId("merchant")
+
+	diags := resourceMerchantReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, "address", d.Get("address_id"))
+	assert.Equal(t, "Van Nelleweg 1", d.Get("address.0.line_1"))
+	assert.Equal(t, "NL", d.Get("address.0.country_code"))
+	assert.Equal(t, 51.9225, d.Get("address.0.lat"))
+	assert.Equal(t, "bar", d.Get("address.0.metadata.foo"))
+}
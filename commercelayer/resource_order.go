@@ -0,0 +1,304 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func resourceOrder() *schema.Resource {
+	return &schema.Resource{
+		Description: "Orders are the core of the Commercelayer order management. This resource is meant to seed " +
+			"draft orders in test mode organizations, e.g. for end-to-end tests, and refuses to run with the " +
+			"credentials of a live mode application. The line items are created along with the order, any change " +
+			"to them creates a new order.",
+		ReadContext:   resourceOrderReadFunc,
+		CreateContext: resourceOrderCreateFunc,
+		UpdateContext: resourceOrderUpdateFunc,
+		DeleteContext: resourceOrderDeleteFunc,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceOrderImportFunc,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The order unique identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The resource type",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"number": {
+				Description: "The order identifier. Can be customized, if the market allows it.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"status": {
+				Description: "The order status, one of 'draft', 'pending', 'placed', 'approved' or 'cancelled'.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"formatted_total_amount_with_taxes": {
+				Description: "The order's total amount, taxes included, formatted.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"attributes": {
+				Description: "Resource attributes",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_email": {
+							Description: "The email address of the associated customer. When creating or updating " +
+								"an order, this is a shortcut to find or create the associated customer by email.",
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"language_code": {
+							Description: "The preferred language code (ISO 639-1) to be used when communicating " +
+								"with the customer. This can be useful when sending the order to 3rd party " +
+								"marketing tools and CRMs.",
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateDiagFunc: validation.ToDiagFunc(
+								validation.StringMatch(regexp.MustCompile("^[a-z]{2}$"), "must be an ISO 639-1 language code")),
+						},
+						"reference": {
+							Description: "A string that you can use to add any external identifier to the resource. This " +
+								"can be useful for integrating the resource to an external system, like an ERP, a " +
+								"marketing tool, a CRM, or whatever.",
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"reference_origin": {
+							Description: "Any identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"metadata": {
+							Description: "Set of key-value pairs that you can attach to the resource. This can be useful " +
+								"for storing additional information about the resource in a structured format",
+							Type: schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Optional: true,
+						},
+					},
+				},
+			},
+			"line_item": {
+				Description: "The line items of the order, identified by their SKU code.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sku_code": {
+							Description: "The code of the associated SKU.",
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+						},
+						"quantity": {
+							Description:      "The line item quantity.",
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ForceNew:         true,
+							Default:          1,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+						},
+					},
+				},
+			},
+			"relationships": {
+				Description: "Resource relationships",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				MinItems:    1,
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"market_id": {
+							Description: "The associated market id.",
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceOrderReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	resp, _, err := c.OrdersApi.GETOrdersOrderId(ctx, d.Id()).Execute()
+	if err != nil {
+		return diagReadErr(d, err)
+	}
+
+	order, ok := resp.GetDataOk()
+	if !ok {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(order.GetId())
+
+	attributes := order.GetAttributes()
+	values := map[string]interface{}{
+		"number":                            fmt.Sprint(attributes.GetNumber()),
+		"status":                            attributes.GetStatus(),
+		"formatted_total_amount_with_taxes": attributes.GetFormattedTotalAmountWithTaxes(),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceOrderCreateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	err := requireTestMode(c)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	attributes := nestedMap(d.Get("attributes"))
+	relationships := nestedMap(d.Get("relationships"))
+
+	orderCreate := commercelayer.OrderCreate{
+		Data: commercelayer.OrderCreateData{
+			Type: ordersType,
+			Attributes: commercelayer.POSTOrders201ResponseDataAttributes{
+				CustomerEmail:   stringRef(attributes["customer_email"]),
+				LanguageCode:    stringRef(attributes["language_code"]),
+				Reference:       stringRef(attributes["reference"]),
+				ReferenceOrigin: stringRef(attributes["reference_origin"]),
+				Metadata:        keyValueRef(attributes["metadata"]),
+			},
+			Relationships: &commercelayer.OrderCreateDataRelationships{
+				Market: &commercelayer.BillingInfoValidationRuleCreateDataRelationshipsMarket{
+					Data: commercelayer.AvalaraAccountDataRelationshipsMarketsData{
+						Type: stringRef(marketType),
+						Id:   stringRef(relationships["market_id"]),
+					},
+				},
+			},
+		},
+	}
+
+	err = d.Set("type", ordersType)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	order, _, err := c.OrdersApi.POSTOrders(ctx).OrderCreate(orderCreate).Execute()
+	if err != nil {
+		return diagErr(err)
+	}
+
+	d.SetId(*order.Data.Id)
+
+	for _, lineItem := range d.Get("line_item").([]interface{}) {
+		lineItem := lineItem.(map[string]interface{})
+
+		lineItemCreate := commercelayer.LineItemCreate{
+			Data: commercelayer.LineItemCreateData{
+				Type: lineItemsType,
+				Attributes: commercelayer.POSTLineItems201ResponseDataAttributes{
+					SkuCode:  stringRef(lineItem["sku_code"]),
+					Quantity: int32(lineItem["quantity"].(int)),
+				},
+				Relationships: &commercelayer.LineItemCreateDataRelationships{
+					Order: commercelayer.AdyenPaymentCreateDataRelationshipsOrder{
+						Data: commercelayer.AdyenPaymentDataRelationshipsOrderData{
+							Type: stringRef(ordersType),
+							Id:   stringRef(d.Id()),
+						},
+					},
+				},
+			},
+		}
+
+		_, _, err := c.LineItemsApi.POSTLineItems(ctx).LineItemCreate(lineItemCreate).Execute()
+		if err != nil {
+			return diagErr(err)
+		}
+	}
+
+	return resourceOrderReadFunc(ctx, d, i)
+}
+
+// resourceOrderImportFunc only imports orders in test mode, so that a live order can't be imported and destroyed
+func resourceOrderImportFunc(ctx context.Context, d *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+	c := i.(*commercelayer.APIClient)
+
+	err := requireTestMode(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema.ImportStatePassthroughContext(ctx, d, i)
+}
+
+func resourceOrderDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	err := requireTestMode(c)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	_, err = c.OrdersApi.DELETEOrdersOrderId(ctx, d.Id()).Execute()
+	return diagErr(err)
+}
+
+func resourceOrderUpdateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	err := requireTestMode(c)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	attributes := nestedMap(d.Get("attributes"))
+
+	var orderUpdate = commercelayer.OrderUpdate{
+		Data: commercelayer.OrderUpdateData{
+			Type: ordersType,
+			Id:   d.Id(),
+			Attributes: commercelayer.PATCHOrdersOrderId200ResponseDataAttributes{
+				CustomerEmail:   stringRef(attributes["customer_email"]),
+				LanguageCode:    stringRef(attributes["language_code"]),
+				Reference:       stringRef(attributes["reference"]),
+				ReferenceOrigin: stringRef(attributes["reference_origin"]),
+				Metadata:        keyValueRef(attributes["metadata"]),
+			},
+		},
+	}
+
+	_, _, err = c.OrdersApi.PATCHOrdersOrderId(ctx, d.Id()).OrderUpdate(orderUpdate).Execute()
+	if err != nil {
+		return diagErr(err)
+	}
+
+	return resourceOrderReadFunc(ctx, d, i)
+}
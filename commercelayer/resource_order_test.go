@@ -0,0 +1,150 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAccCheckOrderDestroy(s *terraform.State) error {
+	client := testAccProviderCommercelayer.Meta().(*commercelayer.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "commercelayer_order" {
+			_, resp, err := client.OrdersApi.GETOrdersOrderId(context.Background(), rs.Primary.ID).Execute()
+			if resp.StatusCode == 404 {
+				fmt.Printf("commercelayer_order with id %s has been removed\n", rs.Primary.ID)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("received response code with status %d", resp.StatusCode)
+		}
+	}
+	return nil
+}
+
+func (s *AcceptanceSuite) TestAccOrder_basic() {
+	resourceName := "commercelayer_order.incentro_order"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckOrderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{
+					testAccAddressCreate(resourceName),
+					testAccInventoryModelCreate(resourceName),
+					testAccMerchantCreate(resourceName),
+					testAccPriceListCreate(resourceName),
+					testAccExternalTaxCalculatorCreate(resourceName),
+					testAccMarketCreate(resourceName),
+					testAccShippingCategoryCreate(resourceName),
+					testAccSkuCreate(resourceName),
+					testAccOrderCreate(resourceName)}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", ordersType),
+					resource.TestCheckResourceAttr(resourceName, "status", "draft"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.customer_email", "seed@incentro.com"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.language_code", "en"),
+					resource.TestCheckResourceAttr(resourceName, "line_item.0.quantity", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "number"),
+				),
+			},
+			{
+				Config: strings.Join([]string{
+					testAccAddressCreate(resourceName),
+					testAccInventoryModelCreate(resourceName),
+					testAccMerchantCreate(resourceName),
+					testAccPriceListCreate(resourceName),
+					testAccExternalTaxCalculatorCreate(resourceName),
+					testAccMarketCreate(resourceName),
+					testAccShippingCategoryCreate(resourceName),
+					testAccSkuCreate(resourceName),
+					testAccOrderUpdate(resourceName)}, "\n",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.language_code", "nl"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.bar", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrderCreate(testName string) string {
+	return hclTemplate(`
+		resource "commercelayer_order" "incentro_order" {
+		  attributes {
+			customer_email = "seed@incentro.com"
+			language_code  = "en"
+			metadata = {
+			  foo : "bar"
+			  testName: "{{.testName}}"
+			}
+		  }
+
+		  line_item {
+			sku_code = commercelayer_sku.incentro_sku.attributes[0].code
+			quantity = 2
+		  }
+
+		  relationships {
+			market_id = commercelayer_market.incentro_market.id
+		  }
+		}
+	`, map[string]any{"testName": testName})
+}
+
+func testAccOrderUpdate(testName string) string {
+	return hclTemplate(`
+		resource "commercelayer_order" "incentro_order" {
+		  attributes {
+			customer_email = "seed@incentro.com"
+			language_code  = "nl"
+			metadata = {
+			  bar : "foo"
+			  testName: "{{.testName}}"
+			}
+		  }
+
+		  line_item {
+			sku_code = commercelayer_sku.incentro_sku.attributes[0].code
+			quantity = 2
+		  }
+
+		  relationships {
+			market_id = commercelayer_market.incentro_market.id
+		  }
+		}
+	`, map[string]any{"testName": testName})
+}
+
+func TestResourceOrderRefusesLiveMode(t *testing.T) {
+	c := testTokenClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s %s in live mode", r.Method, r.URL.Path)
+	}, `{"organization":{"slug":"incentro"},"test":false}`)
+
+	d := schema.TestResourceDataRaw(t, resourceOrder().Schema, map[string]interface{}{})
+	d.SetId("live-order")
+
+	diags := resourceOrderDeleteFunc(context.Background(), d, c)
+	assert.True(t, diags.HasError())
+
+	_, err := resourceOrderImportFunc(context.Background(), d, c)
+	assert.Error(t, err)
+}
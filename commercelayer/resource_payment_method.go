@@ -59,6 +59,13 @@ func resourcePaymentMethod() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						"disabled": {
+							Description: "Indicates if the payment method is disabled, a disabled payment method " +
+								"can not be used on new orders.",
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 						"price_amount_cents": {
 							Description: "The payment method's price, in cents.",
 							Type:        schema.TypeInt,
@@ -118,7 +125,7 @@ func resourcePaymentMethodReadFunc(ctx context.Context, d *schema.ResourceData,
 
 	resp, _, err := c.PaymentMethodsApi.GETPaymentMethodsPaymentMethodId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	address, ok := resp.GetDataOk()
@@ -192,6 +199,10 @@ func resourcePaymentMethodCreateFunc(ctx context.Context, d *schema.ResourceData
 
 	d.SetId(*paymentMethod.Data.Id)
 
+	if attributes["disabled"].(bool) {
+		return resourcePaymentMethodUpdateFunc(ctx, d, i)
+	}
+
 	return nil
 }
 
@@ -251,6 +262,14 @@ func resourcePaymentMethodUpdateFunc(ctx context.Context, d *schema.ResourceData
 			}
 	}
 
+	if d.IsNewResource() || d.HasChange("attributes.0.disabled") {
+		if attributes["disabled"].(bool) {
+			paymentMethodUpdate.Data.Attributes.Disable = boolRef(true)
+		} else {
+			paymentMethodUpdate.Data.Attributes.Enable = boolRef(true)
+		}
+	}
+
 	_, _, err := c.PaymentMethodsApi.PATCHPaymentMethodsPaymentMethodId(ctx, d.Id()).PaymentMethodUpdate(paymentMethodUpdate).Execute()
 
 	return diag.FromErr(err)
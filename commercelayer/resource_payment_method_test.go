@@ -80,6 +80,7 @@ func (s *AcceptanceSuite) TestAccPaymentMethod_basic() {
 				Config: strings.Join([]string{testAccAdyenGatewayCreate(resourceName), testAccPaymentMethodUpdate(resourceName)}, "\n"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.bar", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.disabled", "true"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.currency_code", "EUR"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.payment_source_type", "AdyenPayment"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.price_amount_cents", "0"),
@@ -116,6 +117,7 @@ func testAccPaymentMethodUpdate(testName string) string {
       		payment_source_type    = "AdyenPayment"
 			currency_code          = "EUR"
 			price_amount_cents     = 0
+			disabled               = true
 			metadata               = {
 			  bar : "foo"
 		 	  testName: "{{.testName}}"
@@ -14,9 +14,9 @@ func resourcePaypalGateway() *schema.Resource {
 			"your organization and connect your PayPal account by adding your client ID and secret " +
 			"(contact PayPal's support if you are not sure about the requested data).",
 		ReadContext:   resourcePaypalGatewayReadFunc,
-		CreateContext: resourcePaypalGatewayCreateFunc,
+		CreateContext: adoptableCreateFunc(paypalGatewaysType, resourcePaypalGatewayCreateFunc, resourcePaypalGatewayUpdateFunc),
 		UpdateContext: resourcePaypalGatewayUpdateFunc,
-		DeleteContext: resourcePaypalGatewayDeleteFunc,
+		DeleteContext: retainableDeleteFunc(resourcePaypalGatewayDeleteFunc),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -31,6 +31,7 @@ func resourcePaypalGateway() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"retain_on_destroy": retainOnDestroySchema(),
 			"attributes": {
 				Description: "Resource attributes",
 				Type:        schema.TypeList,
@@ -87,7 +88,7 @@ func resourcePaypalGatewayReadFunc(ctx context.Context, d *schema.ResourceData,
 
 	resp, _, err := c.PaypalGatewaysApi.GETPaypalGatewaysPaypalGatewayId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	paypalGateway, ok := resp.GetDataOk()
@@ -88,7 +88,7 @@ func resourcePriceListReadFunc(ctx context.Context, d *schema.ResourceData, i in
 
 	resp, _, err := c.PriceListsApi.GETPriceListsPriceListId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	priceList, ok := resp.GetDataOk()
@@ -76,7 +76,7 @@ func resourceShippingCategoryReadFunc(ctx context.Context, d *schema.ResourceDat
 
 	resp, _, err := c.ShippingCategoriesApi.GETShippingCategoriesShippingCategoryId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	shippingCategory, ok := resp.GetDataOk()
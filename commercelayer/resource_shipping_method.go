@@ -149,7 +149,7 @@ func resourceShippingMethodReadFunc(ctx context.Context, d *schema.ResourceData,
 
 	resp, _, err := c.ShippingMethodsApi.GETShippingMethodsShippingMethodId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	shippingMethod, ok := resp.GetDataOk()
@@ -0,0 +1,202 @@
+package commercelayer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func resourceShippingWeightTier() *schema.Resource {
+	return &schema.Resource{
+		Description: "Shipping weight tiers define the price of a shipping method with a 'weight_tiered' scheme, " +
+			"based on the total weight of the shipment. The price of the first tier whose up_to weight is not " +
+			"exceeded is applied.",
+		ReadContext:   resourceShippingWeightTierReadFunc,
+		CreateContext: resourceShippingWeightTierCreateFunc,
+		UpdateContext: resourceShippingWeightTierUpdateFunc,
+		DeleteContext: resourceShippingWeightTierDeleteFunc,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The shipping weight tier unique identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The resource type",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"attributes": {
+				Description: "Resource attributes",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				MinItems:    1,
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The shipping weight tier's name",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"up_to": {
+							Description: "The tier upper limit, expressed in the shipping method's unit of weight. " +
+								"When omitted, the tier applies to any weight.",
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+						"price_amount_cents": {
+							Description: "The price of this shipping method tier, in cents.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"reference": {
+							Description: "A string that you can use to add any external identifier to the resource. This " +
+								"can be useful for integrating the resource to an external system, like an ERP, a " +
+								"marketing tool, a CRM, or whatever.",
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"reference_origin": {
+							Description: "Any identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"metadata": {
+							Description: "Set of key-value pairs that you can attach to the resource. This can be useful " +
+								"for storing additional information about the resource in a structured format",
+							Type: schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Optional: true,
+						},
+					},
+				},
+			},
+			"relationships": {
+				Description: "Resource relationships",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				MinItems:    1,
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"shipping_method_id": {
+							Description: "The associated shipping method id.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceShippingWeightTierReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	resp, _, err := c.ShippingWeightTiersApi.GETShippingWeightTiersShippingWeightTierId(ctx, d.Id()).Execute()
+	if err != nil {
+		return diagReadErr(d, err)
+	}
+
+	shippingWeightTier, ok := resp.GetDataOk()
+	if !ok {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(shippingWeightTier.GetId())
+
+	return nil
+}
+
+func resourceShippingWeightTierCreateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	attributes := nestedMap(d.Get("attributes"))
+	relationships := nestedMap(d.Get("relationships"))
+
+	shippingWeightTierCreate := commercelayer.ShippingWeightTierCreate{
+		Data: commercelayer.ShippingWeightTierCreateData{
+			Type: shippingWeightTiersType,
+			Attributes: commercelayer.POSTShippingWeightTiers201ResponseDataAttributes{
+				Name:             attributes["name"].(string),
+				UpTo:             float64ToFloat32Ref(attributes["up_to"]),
+				PriceAmountCents: int32(attributes["price_amount_cents"].(int)),
+				Reference:        stringRef(attributes["reference"]),
+				ReferenceOrigin:  stringRef(attributes["reference_origin"]),
+				Metadata:         keyValueRef(attributes["metadata"]),
+			},
+			Relationships: &commercelayer.ShippingWeightTierCreateDataRelationships{
+				ShippingMethod: commercelayer.DeliveryLeadTimeCreateDataRelationshipsShippingMethod{
+					Data: commercelayer.DeliveryLeadTimeDataRelationshipsShippingMethodData{
+						Type: stringRef(shippingMethodType),
+						Id:   stringRef(relationships["shipping_method_id"]),
+					}},
+			},
+		},
+	}
+
+	err := d.Set("type", shippingWeightTiersType)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	shippingWeightTier, _, err := c.ShippingWeightTiersApi.POSTShippingWeightTiers(ctx).
+		ShippingWeightTierCreate(shippingWeightTierCreate).Execute()
+	if err != nil {
+		return diagErr(err)
+	}
+
+	d.SetId(*shippingWeightTier.Data.Id)
+
+	return nil
+}
+
+func resourceShippingWeightTierDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+	_, err := c.ShippingWeightTiersApi.DELETEShippingWeightTiersShippingWeightTierId(ctx, d.Id()).Execute()
+	return diag.FromErr(err)
+}
+
+func resourceShippingWeightTierUpdateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	attributes := nestedMap(d.Get("attributes"))
+	relationships := nestedMap(d.Get("relationships"))
+
+	var shippingWeightTierUpdate = commercelayer.ShippingWeightTierUpdate{
+		Data: commercelayer.ShippingWeightTierUpdateData{
+			Type: shippingWeightTiersType,
+			Id:   d.Id(),
+			Attributes: commercelayer.PATCHShippingWeightTiersShippingWeightTierId200ResponseDataAttributes{
+				Name:             stringRef(attributes["name"]),
+				UpTo:             float64ToFloat32Ref(attributes["up_to"]),
+				PriceAmountCents: intToInt32Ref(attributes["price_amount_cents"]),
+				Reference:        stringRef(attributes["reference"]),
+				ReferenceOrigin:  stringRef(attributes["reference_origin"]),
+				Metadata:         keyValueRef(attributes["metadata"]),
+			},
+			Relationships: &commercelayer.ShipmentUpdateDataRelationships{
+				ShippingMethod: &commercelayer.DeliveryLeadTimeCreateDataRelationshipsShippingMethod{
+					Data: commercelayer.DeliveryLeadTimeDataRelationshipsShippingMethodData{
+						Type: stringRef(shippingMethodType),
+						Id:   stringRef(relationships["shipping_method_id"]),
+					}},
+			},
+		},
+	}
+
+	_, _, err := c.ShippingWeightTiersApi.PATCHShippingWeightTiersShippingWeightTierId(ctx, d.Id()).
+		ShippingWeightTierUpdate(shippingWeightTierUpdate).Execute()
+
+	return diag.FromErr(err)
+}
@@ -0,0 +1,117 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"net/http"
+	"strings"
+)
+
+func testAccCheckShippingWeightTierDestroy(s *terraform.State) error {
+	client := testAccProviderCommercelayer.Meta().(*commercelayer.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "commercelayer_shipping_weight_tier" {
+			_, resp, err := client.ShippingWeightTiersApi.
+				GETShippingWeightTiersShippingWeightTierId(context.Background(), rs.Primary.ID).Execute()
+			if resp.StatusCode == 404 {
+				fmt.Printf("commercelayer_shipping_weight_tier with id %s has been removed\n", rs.Primary.ID)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("received response code with status %d", resp.StatusCode)
+		}
+
+		if rs.Type == "commercelayer_shipping_method" {
+			err := retryRemoval(10, func() (*http.Response, error) {
+				_, resp, err := client.ShippingMethodsApi.GETShippingMethodsShippingMethodId(context.Background(), rs.Primary.ID).
+					Execute()
+				return resp, err
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *AcceptanceSuite) TestAccShippingWeightTier_basic() {
+	resourceName := "commercelayer_shipping_weight_tier.incentro_shipping_weight_tier"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckShippingWeightTierDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{testAccShippingMethodUpdate(resourceName), testAccShippingWeightTierCreate(resourceName)}, "\n"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", shippingWeightTiersType),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.name", "Incentro Shipping Weight Tier"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.up_to", "5"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.price_amount_cents", "500"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.foo", "bar"),
+				),
+			},
+			{
+				Config: strings.Join([]string{testAccShippingMethodUpdate(resourceName), testAccShippingWeightTierUpdate(resourceName)}, "\n"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.name", "Incentro Shipping Weight Tier Updated"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.up_to", "10"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.price_amount_cents", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.bar", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccShippingWeightTierCreate(testName string) string {
+	return hclTemplate(`
+		resource "commercelayer_shipping_weight_tier" "incentro_shipping_weight_tier" {
+		  attributes {
+			name               = "Incentro Shipping Weight Tier"
+			up_to              = 5
+			price_amount_cents = 500
+			metadata = {
+			  foo : "bar"
+		 	  testName: "{{.testName}}"
+			}
+		  }
+
+		  relationships {
+			shipping_method_id = commercelayer_shipping_method.incentro_shipping_method.id
+		  }
+		}
+	`, map[string]any{"testName": testName})
+}
+
+func testAccShippingWeightTierUpdate(testName string) string {
+	return hclTemplate(`
+		resource "commercelayer_shipping_weight_tier" "incentro_shipping_weight_tier" {
+		  attributes {
+			name               = "Incentro Shipping Weight Tier Updated"
+			up_to              = 10
+			price_amount_cents = 1000
+			metadata = {
+			  bar : "foo"
+		 	  testName: "{{.testName}}"
+			}
+		  }
+
+		  relationships {
+			shipping_method_id = commercelayer_shipping_method.incentro_shipping_method.id
+		  }
+		}
+	`, map[string]any{"testName": testName})
+}
@@ -43,37 +43,43 @@ func resourceShippingZone() *schema.Resource {
 							Required:    true,
 						},
 						"country_code_regex": {
-							Description: "The regex that will be evaluated to match the shipping address country code.",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Description:      "The regex that will be evaluated to match the shipping address country code.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: countryCodeRegexValidation,
 						},
 						"not_country_code_regex": {
 							Description: "The regex that will be evaluated as negative match for the shipping " +
 								"address country code.",
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: regexValidation,
 						},
 						"state_code_regex": {
-							Description: "The regex that will be evaluated to match the shipping address state code.",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Description:      "The regex that will be evaluated to match the shipping address state code.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: regexValidation,
 						},
 						"not_state_code_regex": {
 							Description: "The regex that will be evaluated as negative match for the shipping " +
 								"address state code.",
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: regexValidation,
 						},
 						"zip_code_regex": {
-							Description: "The regex that will be evaluated to match the shipping address zip code.",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Description:      "The regex that will be evaluated to match the shipping address zip code.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: regexValidation,
 						},
 						"not_zip_code_regex": {
 							Description: "The regex that will be evaluated as negative match for the shipping zip " +
 								"country code.",
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: regexValidation,
 						},
 						"reference": {
 							Description: "A string that you can use to add any external identifier to the resource. This " +
@@ -108,7 +114,7 @@ func resourceShippingZoneReadFunc(ctx context.Context, d *schema.ResourceData, i
 
 	resp, _, err := c.ShippingZonesApi.GETShippingZonesShippingZoneId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	shippingZone, ok := resp.GetDataOk()
@@ -0,0 +1,247 @@
+package commercelayer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func resourceSkuOption() *schema.Resource {
+	return &schema.Resource{
+		Description: "SKU options are additional services that can be added to the line items of an order, like " +
+			"engraving or gift wrapping. An option can be restricted to the SKUs whose code matches a regular " +
+			"expression, and can delay the shipment of the line items it is added to.",
+		ReadContext:   resourceSkuOptionReadFunc,
+		CreateContext: resourceSkuOptionCreateFunc,
+		UpdateContext: resourceSkuOptionUpdateFunc,
+		DeleteContext: resourceSkuOptionDeleteFunc,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Description: "The SKU option unique identifier",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"type": {
+				Description: "The resource type",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"delay_days": {
+				Description: "The delay_hours converted in days, rounded.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"attributes": {
+				Description: "Resource attributes",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				MinItems:    1,
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The SKU option's internal name.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"currency_code": {
+							Description: "The international 3-letter currency code as defined by the ISO 4217 " +
+								"standard.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: currencyCodeValidation,
+						},
+						"description": {
+							Description: "An internal description of the SKU option.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"price_amount_cents": {
+							Description: "The price of this SKU option, in cents.",
+							Type:        schema.TypeInt,
+							Optional:    true,
+						},
+						"delay_hours": {
+							Description: "The delay time (in hours) that should be added to the delivery lead time " +
+								"when this option is purchased.",
+							Type:             schema.TypeInt,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+						},
+						"sku_code_regex": {
+							Description:      "The regex that will be evaluated to match the SKU codes, max size is 5000.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: regexValidation,
+						},
+						"reference": {
+							Description: "A string that you can use to add any external identifier to the resource. This " +
+								"can be useful for integrating the resource to an external system, like an ERP, a " +
+								"marketing tool, a CRM, or whatever.",
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"reference_origin": {
+							Description: "Any identifier of the third party system that defines the reference code",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"metadata": {
+							Description: "Set of key-value pairs that you can attach to the resource. This can be useful " +
+								"for storing additional information about the resource in a structured format",
+							Type: schema.TypeMap,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Optional: true,
+						},
+					},
+				},
+			},
+			"relationships": {
+				Description: "Resource relationships",
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"market_id": {
+							Description: "The associated market id.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSkuOptionReadFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	resp, _, err := c.SkuOptionsApi.GETSkuOptionsSkuOptionId(ctx, d.Id()).Execute()
+	if err != nil {
+		return diagReadErr(d, err)
+	}
+
+	skuOption, ok := resp.GetDataOk()
+	if !ok {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(skuOption.GetId())
+
+	attributes := skuOption.GetAttributes()
+	err = d.Set("delay_days", int(attributes.GetDelayDays()))
+	if err != nil {
+		return diagErr(err)
+	}
+
+	return nil
+}
+
+func resourceSkuOptionCreateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	attributes := nestedMap(d.Get("attributes"))
+	relationships := nestedMap(d.Get("relationships"))
+
+	skuOptionCreate := commercelayer.SkuOptionCreate{
+		Data: commercelayer.SkuOptionCreateData{
+			Type: skuOptionsType,
+			Attributes: commercelayer.POSTSkuOptions201ResponseDataAttributes{
+				Name:             attributes["name"].(string),
+				CurrencyCode:     stringRef(attributes["currency_code"]),
+				Description:      stringRef(attributes["description"]),
+				PriceAmountCents: intToInt32Ref(attributes["price_amount_cents"]),
+				DelayHours:       intToInt32Ref(attributes["delay_hours"]),
+				SkuCodeRegex:     stringRef(attributes["sku_code_regex"]),
+				Reference:        stringRef(attributes["reference"]),
+				ReferenceOrigin:  stringRef(attributes["reference_origin"]),
+				Metadata:         keyValueRef(attributes["metadata"]),
+			},
+		},
+	}
+
+	marketId := stringRef(relationships["market_id"])
+	if marketId != nil {
+		skuOptionCreate.Data.Relationships = &commercelayer.BillingInfoValidationRuleUpdateDataRelationships{
+			Market: &commercelayer.BillingInfoValidationRuleCreateDataRelationshipsMarket{
+				Data: commercelayer.AvalaraAccountDataRelationshipsMarketsData{
+					Type: stringRef(marketType),
+					Id:   marketId,
+				}},
+		}
+	}
+
+	err := d.Set("type", skuOptionsType)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	skuOption, _, err := c.SkuOptionsApi.POSTSkuOptions(ctx).SkuOptionCreate(skuOptionCreate).Execute()
+	if err != nil {
+		return diagErr(err)
+	}
+
+	d.SetId(*skuOption.Data.Id)
+
+	return resourceSkuOptionReadFunc(ctx, d, i)
+}
+
+func resourceSkuOptionDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+	_, err := c.SkuOptionsApi.DELETESkuOptionsSkuOptionId(ctx, d.Id()).Execute()
+	return diag.FromErr(err)
+}
+
+func resourceSkuOptionUpdateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	c := i.(*commercelayer.APIClient)
+
+	attributes := nestedMap(d.Get("attributes"))
+	relationships := nestedMap(d.Get("relationships"))
+
+	var skuOptionUpdate = commercelayer.SkuOptionUpdate{
+		Data: commercelayer.SkuOptionUpdateData{
+			Type: skuOptionsType,
+			Id:   d.Id(),
+			Attributes: commercelayer.PATCHSkuOptionsSkuOptionId200ResponseDataAttributes{
+				Name:             stringRef(attributes["name"]),
+				CurrencyCode:     stringRef(attributes["currency_code"]),
+				Description:      stringRef(attributes["description"]),
+				PriceAmountCents: intToInt32Ref(attributes["price_amount_cents"]),
+				DelayHours:       intToInt32Ref(attributes["delay_hours"]),
+				SkuCodeRegex:     stringRef(attributes["sku_code_regex"]),
+				Reference:        stringRef(attributes["reference"]),
+				ReferenceOrigin:  stringRef(attributes["reference_origin"]),
+				Metadata:         keyValueRef(attributes["metadata"]),
+			},
+		},
+	}
+
+	marketId := stringRef(relationships["market_id"])
+	if marketId != nil {
+		skuOptionUpdate.Data.Relationships = &commercelayer.BillingInfoValidationRuleUpdateDataRelationships{
+			Market: &commercelayer.BillingInfoValidationRuleCreateDataRelationshipsMarket{
+				Data: commercelayer.AvalaraAccountDataRelationshipsMarketsData{
+					Type: stringRef(marketType),
+					Id:   marketId,
+				}},
+		}
+	}
+
+	_, _, err := c.SkuOptionsApi.PATCHSkuOptionsSkuOptionId(ctx, d.Id()).SkuOptionUpdate(skuOptionUpdate).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSkuOptionReadFunc(ctx, d, i)
+}
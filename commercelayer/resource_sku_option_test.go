@@ -0,0 +1,100 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+)
+
+func testAccCheckSkuOptionDestroy(s *terraform.State) error {
+	client := testAccProviderCommercelayer.Meta().(*commercelayer.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "commercelayer_sku_option" {
+			_, resp, err := client.SkuOptionsApi.GETSkuOptionsSkuOptionId(context.Background(), rs.Primary.ID).Execute()
+			if resp.StatusCode == 404 {
+				fmt.Printf("commercelayer_sku_option with id %s has been removed\n", rs.Primary.ID)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("received response code with status %d", resp.StatusCode)
+		}
+	}
+
+	return nil
+}
+
+func (s *AcceptanceSuite) TestAccSkuOption_basic() {
+	resourceName := "commercelayer_sku_option.incentro_sku_option"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSkuOptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSkuOptionCreate(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", skuOptionsType),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.name", "Incentro Engraving"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.delay_hours", "48"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.sku_code_regex", "^TSHIRT"),
+					resource.TestCheckResourceAttr(resourceName, "delay_days", "2"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.foo", "bar"),
+				),
+			},
+			{
+				Config: testAccSkuOptionUpdate(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.name", "Incentro Gift Wrapping"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.delay_hours", "24"),
+					resource.TestCheckResourceAttr(resourceName, "delay_days", "1"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.bar", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSkuOptionCreate(testName string) string {
+	return hclTemplate(`
+		resource "commercelayer_sku_option" "incentro_sku_option" {
+		  attributes {
+			name               = "Incentro Engraving"
+			currency_code      = "EUR"
+			price_amount_cents = 500
+			delay_hours        = 48
+			sku_code_regex     = "^TSHIRT"
+			metadata = {
+			  foo : "bar"
+		 	  testName: "{{.testName}}"
+			}
+		  }
+		}
+	`, map[string]any{"testName": testName})
+}
+
+func testAccSkuOptionUpdate(testName string) string {
+	return hclTemplate(`
+		resource "commercelayer_sku_option" "incentro_sku_option" {
+		  attributes {
+			name               = "Incentro Gift Wrapping"
+			currency_code      = "EUR"
+			price_amount_cents = 300
+			delay_hours        = 24
+			sku_code_regex     = "^TSHIRT"
+			metadata = {
+			  bar : "foo"
+		 	  testName: "{{.testName}}"
+			}
+		  }
+		}
+	`, map[string]any{"testName": testName})
+}
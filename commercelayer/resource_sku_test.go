@@ -0,0 +1,122 @@
+package commercelayer
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"net/http"
+	"strings"
+)
+
+func testAccCheckSkuDestroy(s *terraform.State) error {
+	client := testAccProviderCommercelayer.Meta().(*commercelayer.APIClient)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type == "commercelayer_sku" {
+			_, resp, err := client.SkusApi.GETSkusSkuId(context.Background(), rs.Primary.ID).Execute()
+			if resp.StatusCode == 404 {
+				fmt.Printf("commercelayer_sku with id %s has been removed\n", rs.Primary.ID)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("received response code with status %d", resp.StatusCode)
+		}
+
+		if rs.Type == "commercelayer_shipping_category" {
+			err := retryRemoval(10, func() (*http.Response, error) {
+				_, resp, err := client.ShippingCategoriesApi.
+					GETShippingCategoriesShippingCategoryId(context.Background(), rs.Primary.ID).Execute()
+				return resp, err
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *AcceptanceSuite) TestAccSku_basic() {
+	resourceName := "commercelayer_sku.incentro_sku"
+
+	resource.Test(s.T(), resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(s)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckSkuDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: strings.Join([]string{testAccShippingCategoryCreate(resourceName), testAccSkuCreate(resourceName)}, "\n"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", skusType),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.code", "INCENTRO-TSHIRT-M"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.weight", "250"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.unit_of_weight", "gr"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.foo", "bar"),
+				),
+			},
+			{
+				Config: strings.Join([]string{testAccShippingCategoryCreate(resourceName), testAccSkuUpdate(resourceName)}, "\n"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.name", "Incentro T-shirt M Updated"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.pieces_per_pack", "2"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.hs_tariff_number", "6109100010"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.do_not_track", "true"),
+					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.bar", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSkuCreate(testName string) string {
+	return hclTemplate(`
+		resource "commercelayer_sku" "incentro_sku" {
+		  attributes {
+			code           = "INCENTRO-TSHIRT-M"
+			name           = "Incentro T-shirt M"
+			weight         = 250
+			unit_of_weight = "gr"
+			metadata = {
+			  foo : "bar"
+		 	  testName: "{{.testName}}"
+			}
+		  }
+
+		  relationships {
+			shipping_category_id = commercelayer_shipping_category.incentro_shipping_category.id
+		  }
+		}
+	`, map[string]any{"testName": testName})
+}
+
+func testAccSkuUpdate(testName string) string {
+	return hclTemplate(`
+		resource "commercelayer_sku" "incentro_sku" {
+		  attributes {
+			code             = "INCENTRO-TSHIRT-M"
+			name             = "Incentro T-shirt M Updated"
+			pieces_per_pack  = 2
+			weight           = 500
+			unit_of_weight   = "gr"
+			hs_tariff_number = "6109100010"
+			do_not_track     = true
+			metadata = {
+			  bar : "foo"
+		 	  testName: "{{.testName}}"
+			}
+		  }
+
+		  relationships {
+			shipping_category_id = commercelayer_shipping_category.incentro_shipping_category.id
+		  }
+		}
+	`, map[string]any{"testName": testName})
+}
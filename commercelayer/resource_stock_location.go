@@ -2,6 +2,7 @@ package commercelayer
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
@@ -78,18 +79,35 @@ func resourceStockLocation() *schema.Resource {
 					},
 				},
 			},
+			"address": {
+				Description: "The address of the stock location. The address is created, updated and destroyed " +
+					"together with the stock location, as an alternative to an address_id relationship. An imported stock " +
+					"location reads its address into this block.",
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: []string{"address", "relationships.0.address_id"},
+				Elem: &schema.Resource{
+					Schema: addressAttributesSchema(),
+				},
+			},
+			"address_id": {
+				Description: "The id of the stock location address, either the associated or the inline address.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"relationships": {
 				Description: "Resource relationships",
 				Type:        schema.TypeList,
 				MaxItems:    1,
-				MinItems:    1,
-				Required:    true,
+				Optional:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"address_id": {
-							Description: "The associated address id.",
-							Type:        schema.TypeString,
-							Required:    true,
+							Description:  "The associated address id.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"address", "relationships.0.address_id"},
 						},
 					},
 				},
@@ -103,7 +121,7 @@ func resourceStockLocationReadFunc(ctx context.Context, d *schema.ResourceData,
 
 	resp, _, err := c.StockLocationsApi.GETStockLocationsStockLocationId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	stockLocation, ok := resp.GetDataOk()
@@ -114,14 +132,18 @@ func resourceStockLocationReadFunc(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(stockLocation.GetId())
 
-	return nil
+	return diagErr(readInlineAddress(ctx, c, d, fmt.Sprintf("/stock_locations/%s/address", stockLocation.GetId())))
 }
 
 func resourceStockLocationCreateFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
 	c := i.(*commercelayer.APIClient)
 
 	attributes := nestedMap(d.Get("attributes"))
-	relationships := nestedMap(d.Get("relationships"))
+
+	addressId, err := syncInlineAddress(ctx, c, d)
+	if err != nil {
+		return diagErr(err)
+	}
 
 	stockLocationCreate := commercelayer.StockLocationCreate{
 		Data: commercelayer.StockLocationCreateData{
@@ -138,20 +160,21 @@ func resourceStockLocationCreateFunc(ctx context.Context, d *schema.ResourceData
 				Address: commercelayer.CustomerAddressCreateDataRelationshipsAddress{
 					Data: commercelayer.BingGeocoderDataRelationshipsAddressesData{
 						Type: stringRef(addressType),
-						Id:   stringRef(relationships["address_id"]),
+						Id:   stringRef(addressId),
 					},
 				},
 			},
 		},
 	}
 
-	err := d.Set("type", stockLocationType)
+	err = d.Set("type", stockLocationType)
 	if err != nil {
 		return diagErr(err)
 	}
 
 	stockLocation, _, err := c.StockLocationsApi.POSTStockLocations(ctx).StockLocationCreate(stockLocationCreate).Execute()
 	if err != nil {
+		discardInlineAddress(ctx, c, d, "", addressId)
 		return diagErr(err)
 	}
 
@@ -163,6 +186,14 @@ func resourceStockLocationCreateFunc(ctx context.Context, d *schema.ResourceData
 func resourceStockLocationDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
 	c := i.(*commercelayer.APIClient)
 	_, err := c.StockLocationsApi.DELETEStockLocationsStockLocationId(ctx, d.Id()).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(d.Get("address").([]any)) > 0 {
+		_, err = c.AddressesApi.DELETEAddressesAddressId(ctx, d.Get("address_id").(string)).Execute()
+	}
+
 	return diag.FromErr(err)
 }
 
@@ -170,7 +201,15 @@ func resourceStockLocationUpdateFunc(ctx context.Context, d *schema.ResourceData
 	c := i.(*commercelayer.APIClient)
 
 	attributes := nestedMap(d.Get("attributes"))
-	relationships := nestedMap(d.Get("relationships"))
+
+	// The previous address is only owned by the stock location when it was defined inline
+	oldAddress, _ := d.GetChange("address")
+	oldAddressId := d.Get("address_id").(string)
+
+	addressId, err := syncInlineAddress(ctx, c, d)
+	if err != nil {
+		return diagErr(err)
+	}
 
 	var stockLocationUpdate = commercelayer.StockLocationUpdate{
 		Data: commercelayer.StockLocationUpdateData{
@@ -188,14 +227,22 @@ func resourceStockLocationUpdateFunc(ctx context.Context, d *schema.ResourceData
 				Address: &commercelayer.CustomerAddressCreateDataRelationshipsAddress{
 					Data: commercelayer.BingGeocoderDataRelationshipsAddressesData{
 						Type: stringRef(addressType),
-						Id:   stringRef(relationships["address_id"]),
+						Id:   stringRef(addressId),
 					},
 				},
 			},
 		},
 	}
 
-	_, _, err := c.StockLocationsApi.PATCHStockLocationsStockLocationId(ctx, d.Id()).StockLocationUpdate(stockLocationUpdate).Execute()
+	_, _, err = c.StockLocationsApi.PATCHStockLocationsStockLocationId(ctx, d.Id()).StockLocationUpdate(stockLocationUpdate).Execute()
+	if err != nil {
+		discardInlineAddress(ctx, c, d, oldAddressId, addressId)
+		return diagErr(err)
+	}
+
+	if len(oldAddress.([]any)) > 0 && oldAddressId != addressId {
+		_, err = c.AddressesApi.DELETEAddressesAddressId(ctx, oldAddressId).Execute()
+	}
 
 	return diag.FromErr(err)
 }
@@ -2,11 +2,15 @@ package commercelayer
 
 import (
 	"context"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"github.com/stretchr/testify/assert"
 	"net/http"
 	"strings"
+	"testing"
 )
 
 func testAccCheckStockLocationDestroy(s *terraform.State) error {
@@ -100,3 +104,95 @@ func testAccStockLocationUpdate(testName string) string {
 		}
 	`, map[string]any{"testName": testName})
 }
+
+func TestStockLocationAddressExactlyOneOf(t *testing.T) {
+	address := []any{map[string]any{
+		"line_1":       "Van Nelleweg 1",
+		"city":         "Rotterdam",
+		"state_code":   "ZH",
+		"country_code": "NL",
+		"phone":        "+31(0)10 20 20 544",
+	}}
+	relationships := []any{map[string]any{
+		"address_id": "address",
+	}}
+	attributes := []any{map[string]any{
+		"name": "Incentro Warehouse",
+	}}
+
+	diags := resourceStockLocation().Validate(terraform.NewResourceConfigRaw(map[string]any{
+		"attributes": attributes,
+		"address":    address,
+	}))
+	assert.False(t, diags.HasError())
+
+	diags = resourceStockLocation().Validate(terraform.NewResourceConfigRaw(map[string]any{
+		"attributes":    attributes,
+		"relationships": relationships,
+	}))
+	assert.False(t, diags.HasError())
+
+	diags = resourceStockLocation().Validate(terraform.NewResourceConfigRaw(map[string]any{
+		"attributes":    attributes,
+		"address":       address,
+		"relationships": relationships,
+	}))
+	assert.True(t, diags.HasError())
+}
+
+func TestResourceStockLocationUpdateDiscardsInlineAddress(t *testing.T) {
+	var deleted []string
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /addresses":
+			w.Header().Set("Content-Type", "application/vnd.api+json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = fmt.Fprint(w, `{"data": {"id": "address", "type": "addresses", "attributes": {"line_1": "Van Nelleweg 1"}}}`)
+		case "PATCH /stock_locations/stock-location":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = fmt.Fprint(w, `{"errors": [{"title": "is invalid"}]}`)
+		case "DELETE /addresses/address":
+			deleted = append(deleted, "address")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceStockLocation().Schema, map[string]interface{}{
+		"attributes": []interface{}{map[string]interface{}{
+			"name": "Incentro Stock Location",
+		}},
+		"address": []interface{}{map[string]interface{}{
+			"line_1":       "Van Nelleweg 1",
+			"city":         "Rotterdam",
+			"state_code":   "ZH",
+			"country_code": "NL",
+			"phone":        "+31(0)10 20 20 544",
+		}},
+	})
+	d.SetId("stock-location")
+
+	diags := resourceStockLocationUpdateFunc(context.Background(), d, c)
+	assert.True(t, diags.HasError())
+	assert.Equal(t, []string{"address"}, deleted)
+}
+
+func TestResourceStockLocationReadReferencedAddress(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/stock_locations/stock-location", r.URL.Path)
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		_, _ = fmt.Fprint(w, `{"data": {"id": "stock-location", "type": "stock_locations", "attributes": {"name": "Incentro"}}}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceStockLocation().Schema, map[string]interface{}{
+		"relationships": []interface{}{map[string]interface{}{
+			"address_id": "address",
+		}},
+	})
+	d.SetId("stock-location")
+
+	diags := resourceStockLocationReadFunc(context.Background(), d, c)
+	assert.False(t, diags.HasError())
+	assert.Empty(t, d.Get("address"))
+}
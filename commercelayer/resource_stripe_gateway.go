@@ -17,9 +17,9 @@ func resourceStripeGateway() *schema.Resource {
 			"gather all the credentials requested (like secret and publishable keys, etc. — contact Stripe's " +
 			"support if you are not sure about the requested data).",
 		ReadContext:   resourceStripeGatewayReadFunc,
-		CreateContext: resourceStripeGatewayCreateFunc,
+		CreateContext: adoptableCreateFunc(stripeGatewaysType, resourceStripeGatewayCreateFunc, resourceStripeGatewayUpdateFunc),
 		UpdateContext: resourceStripeGatewayUpdateFunc,
-		DeleteContext: resourceStripeGatewayDeleteFunc,
+		DeleteContext: retainableDeleteFunc(resourceStripeGatewayDeleteFunc),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -34,6 +34,23 @@ func resourceStripeGateway() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"retain_on_destroy": retainOnDestroySchema(),
+			"webhook_endpoint_id": {
+				Description: "The gateway webhook endpoint ID, generated automatically.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"webhook_endpoint_secret": {
+				Description: "The gateway webhook endpoint secret, generated automatically.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"webhook_endpoint_url": {
+				Description: "The gateway webhook URL, generated automatically.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 			"attributes": {
 				Description: "Resource attributes",
 				Type:        schema.TypeList,
@@ -90,7 +107,7 @@ func resourceStripeGatewayReadFunc(ctx context.Context, d *schema.ResourceData,
 
 	resp, _, err := c.StripeGatewaysApi.GETStripeGatewaysStripeGatewayId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	stripeGateway, ok := resp.GetDataOk()
@@ -101,6 +118,19 @@ func resourceStripeGatewayReadFunc(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(stripeGateway.GetId())
 
+	attributes := stripeGateway.GetAttributes()
+	values := map[string]interface{}{
+		"webhook_endpoint_id":     attributes.GetWebhookEndpointId(),
+		"webhook_endpoint_secret": attributes.GetWebhookEndpointSecret(),
+		"webhook_endpoint_url":    attributes.GetWebhookEndpointUrl(),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
 	return nil
 }
 
@@ -135,7 +165,7 @@ func resourceStripeGatewayCreateFunc(ctx context.Context, d *schema.ResourceData
 
 	d.SetId(*stripeGateway.Data.Id)
 
-	return nil
+	return resourceStripeGatewayReadFunc(ctx, d, i)
 }
 
 func resourceStripeGatewayDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -164,6 +194,9 @@ func resourceStripeGatewayUpdateFunc(ctx context.Context, d *schema.ResourceData
 
 	_, _, err := c.StripeGatewaysApi.PATCHStripeGatewaysStripeGatewayId(ctx, d.Id()).
 		StripeGatewayUpdate(stripeGatewayUpdate).Execute()
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	return diag.FromErr(err)
+	return resourceStripeGatewayReadFunc(ctx, d, i)
 }
@@ -46,6 +46,7 @@ func (s *AcceptanceSuite) TestAccStripeGateway_basic() {
 					resource.TestCheckResourceAttr(resourceName, "type", stripeGatewaysType),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.name", "Incentro Stripe Gateway"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.foo", "bar"),
+					resource.TestCheckResourceAttrSet(resourceName, "webhook_endpoint_url"),
 				),
 			},
 			{
@@ -80,7 +80,7 @@ func resourceTaxjarAccountReadFunc(ctx context.Context, d *schema.ResourceData,
 
 	resp, _, err := c.TaxjarAccountsApi.GETTaxjarAccountsTaxjarAccountId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	taxjarAccount, ok := resp.GetDataOk()
@@ -35,6 +35,37 @@ func resourceWebhook() *schema.Resource {
 				Computed:    true,
 				Sensitive:   true,
 			},
+			"circuit_state": {
+				Description: "The circuit breaker state, by default it is 'closed'. It can become 'open' once the " +
+					"number of consecutive failures overlaps the specified threshold, in such case no further " +
+					"calls to the failing callback are made.",
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"circuit_failure_count": {
+				Description: "The number of consecutive failures recorded by the circuit breaker associated to " +
+					"this resource, will be reset on first successful call to callback.",
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"reset_circuit_trigger": {
+				Description: "Arbitrary value that, when changed, resets the circuit breaker of the webhook, e.g. " +
+					"the id of the incident that tripped it. This closes an open circuit so that the callback " +
+					"is called again.",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"keepers": {
+				Description: "Arbitrary map of values that, when changed, replace the webhook. Commercelayer can not " +
+					"regenerate the shared secret of an existing webhook, so this is the way to rotate it, e.g. " +
+					"with a rotation date.",
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+				ForceNew: true,
+			},
 			"attributes": {
 				Description: "Resource attributes",
 				Type:        schema.TypeList,
@@ -101,7 +132,7 @@ func resourceWebhookReadFunc(ctx context.Context, d *schema.ResourceData, i inte
 
 	resp, _, err := c.WebhooksApi.GETWebhooksWebhookId(ctx, d.Id()).Execute()
 	if err != nil {
-		return diagErr(err)
+		return diagReadErr(d, err)
 	}
 
 	webhook, ok := resp.GetDataOk()
@@ -112,6 +143,19 @@ func resourceWebhookReadFunc(ctx context.Context, d *schema.ResourceData, i inte
 
 	d.SetId(webhook.GetId())
 
+	attributes := webhook.GetAttributes()
+	values := map[string]interface{}{
+		"shared_secret":         attributes.GetSharedSecret(),
+		"circuit_state":         attributes.GetCircuitState(),
+		"circuit_failure_count": int(attributes.GetCircuitFailureCount()),
+	}
+
+	for key, value := range values {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("failed to set %s: %s", key, err)
+		}
+	}
+
 	return nil
 }
 
@@ -148,19 +192,7 @@ func resourceWebhookCreateFunc(ctx context.Context, d *schema.ResourceData, i in
 	d.SetId(*webhook.Data.Id)
 
 	//Fetch the shared secret (this is a work-around because the create does not return it)
-	resp, _, err := c.WebhooksApi.GETWebhooksWebhookId(ctx, *webhook.Data.Id).Execute()
-	if err != nil {
-		return diagErr(err)
-	}
-
-	getWebhook := resp.GetData()
-
-	err = d.Set("shared_secret", &getWebhook.Attributes.SharedSecret)
-	if err != nil {
-		return diagErr(err)
-	}
-
-	return nil
+	return resourceWebhookReadFunc(ctx, d, i)
 }
 
 func resourceWebhookDeleteFunc(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -190,8 +222,11 @@ func resourceWebhookUpdateFunc(ctx context.Context, d *schema.ResourceData, i in
 		},
 	}
 
+	if d.HasChange("reset_circuit_trigger") {
+		webhookUpdate.Data.Attributes.ResetCircuit = boolRef(true)
+	}
+
 	_, _, err := c.WebhooksApi.PATCHWebhooksWebhookId(ctx, d.Id()).WebhookUpdate(webhookUpdate).Execute()
 
 	return diag.FromErr(err)
-
 }
@@ -60,6 +60,8 @@ func (s *AcceptanceSuite) TestAccWebhook_basic() {
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.include_resources.0", "line_items"),
 					resource.TestCheckResourceAttr(resourceName, "attributes.0.metadata.bar", "foo"),
 					resource.TestCheckResourceAttrSet(resourceName, "shared_secret"),
+					resource.TestCheckResourceAttr(resourceName, "circuit_state", "closed"),
+					resource.TestCheckResourceAttr(resourceName, "circuit_failure_count", "0"),
 				),
 			},
 		},
@@ -88,6 +90,8 @@ func testAccWebhookCreate(testName string) string {
 func testAccWebhookUpdate(testName string) string {
 	return hclTemplate(`
 		resource "commercelayer_webhook" "incentro_webhook" {
+		  reset_circuit_trigger = "incident-1"
+
 		  attributes {
 			name         = "incentro updated webhook"
 			topic        = "orders.place"
@@ -0,0 +1,123 @@
+package commercelayer
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"golang.org/x/oauth2"
+)
+
+// accessTokenClaims are the claims of a Commercelayer access token, which is a JWT
+type accessTokenClaims struct {
+	Organization struct {
+		Id   string `json:"id"`
+		Slug string `json:"slug"`
+	} `json:"organization"`
+	Application struct {
+		Id     string `json:"id"`
+		Kind   string `json:"kind"`
+		Public bool   `json:"public"`
+	} `json:"application"`
+	Scope string `json:"scope"`
+	Test  *bool  `json:"test"`
+}
+
+// requireTestMode returns an error unless the access token of the client has been issued for the test mode of the
+// organization. It guards resources that are only meant to seed sandbox data, like orders, against live mode.
+func requireTestMode(c *commercelayer.APIClient) error {
+	accessToken, err := clientAccessToken(c)
+	if err != nil {
+		return err
+	}
+
+	testMode, err := isTestModeToken(accessToken)
+	if err != nil {
+		return err
+	}
+
+	if !testMode {
+		return errors.New("refusing to run against live mode, use the credentials of a test mode application")
+	}
+
+	return nil
+}
+
+// clientAccessTokenClaims returns the claims of the access token the client authenticates with
+func clientAccessTokenClaims(c *commercelayer.APIClient) (*accessTokenClaims, error) {
+	accessToken, err := clientAccessToken(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeAccessToken(accessToken)
+}
+
+// clientAccessToken returns the access token the client authenticates with, fetching it when the client has none yet
+func clientAccessToken(c *commercelayer.APIClient) (string, error) {
+	transport, ok := c.GetConfig().HTTPClient.Transport.(*oauth2.Transport)
+	if !ok {
+		return "", errors.New("unable to read the access token of the client")
+	}
+
+	token, err := transport.Source.Token()
+	if err != nil {
+		return "", err
+	}
+
+	return token.AccessToken, nil
+}
+
+// isTestModeToken reads the test claim of a Commercelayer access token
+func isTestModeToken(accessToken string) (bool, error) {
+	claims, err := decodeAccessToken(accessToken)
+	if err != nil {
+		return false, err
+	}
+
+	return claims.testMode()
+}
+
+func decodeAccessToken(accessToken string) (*accessTokenClaims, error) {
+	parts := strings.Split(accessToken, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("the access token is not a JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the access token: %w", err)
+	}
+
+	var claims accessTokenClaims
+	err = json.Unmarshal(payload, &claims)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the access token: %w", err)
+	}
+
+	return &claims, nil
+}
+
+func (c accessTokenClaims) testMode() (bool, error) {
+	if c.Test == nil {
+		return false, errors.New("the access token has no test claim")
+	}
+
+	return *c.Test, nil
+}
+
+// mode returns the mode of the organization the access token has been issued for, either test or live
+func (c accessTokenClaims) mode() (string, error) {
+	testMode, err := c.testMode()
+	if err != nil {
+		return "", err
+	}
+
+	if testMode {
+		return "test", nil
+	}
+	return "live", nil
+}
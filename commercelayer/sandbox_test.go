@@ -0,0 +1,28 @@
+package commercelayer
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsTestModeToken(t *testing.T) {
+	jwt := func(payload string) string {
+		return "header." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+	}
+
+	testMode, err := isTestModeToken(jwt(`{"organization":{"slug":"incentro"},"test":true}`))
+	assert.NoError(t, err)
+	assert.True(t, testMode)
+
+	testMode, err = isTestModeToken(jwt(`{"organization":{"slug":"incentro"},"test":false}`))
+	assert.NoError(t, err)
+	assert.False(t, testMode)
+
+	_, err = isTestModeToken(jwt(`{"organization":{"slug":"incentro"}}`))
+	assert.Error(t, err)
+
+	_, err = isTestModeToken("foobar")
+	assert.Error(t, err)
+}
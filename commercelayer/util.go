@@ -1,18 +1,145 @@
 package commercelayer
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	commercelayer "github.com/incentro-dc/go-commercelayer-sdk/api"
+	"net/http"
+	"time"
 )
 
+// apiError is implemented by the errors of the SDK and by the errors of the queries of the data sources, which both
+// carry the body of the response
+type apiError interface {
+	error
+	Body() []byte
+}
+
 func diagErr(err error) diag.Diagnostics {
-	apiErr, ok := err.(*commercelayer.GenericOpenAPIError)
-	if ok {
-		return diag.Errorf("%s: %s", apiErr.Error(), string(apiErr.Body()))
+	var apiErr apiError
+	if errors.As(err, &apiErr) {
+		if apiErrorStatus(err) == http.StatusForbidden {
+			return diagForbidden(err, apiErr)
+		}
+		return diag.Errorf("%s: %s", err.Error(), string(apiErr.Body()))
 	}
 	return diag.FromErr(err)
 }
 
+// diagReadErr is diagErr for the read functions. A resource that no longer exists is removed from the state, so that
+// terraform plans to create it again instead of failing the refresh. A resource that the credentials are not
+// permitted to read keeps its state with a warning, so that the plan of the other resources goes on.
+func diagReadErr(d *schema.ResourceData, err error) diag.Diagnostics {
+	switch apiErrorStatus(err) {
+	case http.StatusNotFound:
+		d.SetId("")
+		return nil
+	case http.StatusForbidden:
+		return diagForbiddenWarning(err, fmt.Sprintf("%s has not been refreshed", d.Id()))
+	}
+	return diagErr(err)
+}
+
+// diagOptionalErr is diagErr for optional lookups, like the tax rules of a manual tax calculator. When the
+// credentials are not permitted to access them, the lookup is skipped with a warning instead of failing the resource.
+func diagOptionalErr(err error, lookup string) diag.Diagnostics {
+	if apiErrorStatus(err) == http.StatusForbidden {
+		return diagForbiddenWarning(err, fmt.Sprintf("skipped %s", lookup))
+	}
+	return diagErr(err)
+}
+
+// apiErrorStatus returns the HTTP status code of an API error, or 0 when err is no API error. The SDK keeps the
+// status line of the response, e.g. "404 Not Found", as the message of its errors, the code is parsed from it.
+func apiErrorStatus(err error) int {
+	var queryErr *queryError
+	if errors.As(err, &queryErr) {
+		return queryErr.statusCode
+	}
+
+	var apiErr *commercelayer.GenericOpenAPIError
+	if !errors.As(err, &apiErr) {
+		return 0
+	}
+
+	var status int
+	_, _ = fmt.Sscanf(apiErr.Error(), "%d", &status)
+	return status
+}
+
+// forbiddenDetail explains a 403 response, which Commercelayer returns when the credentials are valid but their role
+// or scope does not allow access to the requested resource type
+const forbiddenDetail = "The credentials used by the provider are not permitted to access this resource type. " +
+	"Make sure the API client has a role and scope that grant access to it, or remove the resource " +
+	"from the configuration managed with these credentials."
+
+// diagForbidden fails the resource at hand on a 403. Only that resource fails, so the detail points at the
+// credentials rather than at the configuration.
+func diagForbidden(err error, apiErr apiError) diag.Diagnostics {
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%s: %s", err.Error(), string(apiErr.Body())),
+			Detail:   forbiddenDetail,
+		},
+	}
+}
+
+// diagForbiddenWarning degrades a 403 to a warning, for reads that can be skipped
+func diagForbiddenWarning(err error, skipped string) diag.Diagnostics {
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s: %s", skipped, err.Error()),
+			Detail:   forbiddenDetail,
+		},
+	}
+}
+
+// jobPollInterval is the minimum interval at which the status of an asynchronous job is polled
+const jobPollInterval = 5 * time.Second
+
+// jobStatus is the status of an asynchronous job, like an import, along with its errors log
+type jobStatus struct {
+	status    string
+	errorsLog string
+}
+
+// waitForJob polls an asynchronous job, like an import, until it has either completed or been interrupted, within
+// the create timeout of the resource. refresh fetches the job and sets its status on the resource, so the state holds
+// the last status seen. An interrupted job fails with its errors log.
+func waitForJob(ctx context.Context, d *schema.ResourceData, job string, refresh func() (jobStatus, error)) diag.Diagnostics {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"pending", "in_progress"},
+		Target:  []string{"completed", "interrupted"},
+		Refresh: func() (interface{}, string, error) {
+			status, err := refresh()
+			if err != nil {
+				return nil, "", err
+			}
+			return status, status.status, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: jobPollInterval,
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diagErr(err)
+	}
+
+	status := result.(jobStatus)
+	if status.status == "interrupted" {
+		return diag.Errorf("%s %s has been interrupted: %s", job, d.Id(), status.errorsLog)
+	}
+
+	return nil
+}
+
 func stringRef(val interface{}) *string {
 	if val == nil {
 		return nil
@@ -91,3 +218,16 @@ func nestedMap(val interface{}) map[string]any {
 
 	return valMap[0].(map[string]any)
 }
+
+// stringMap converts a map returned by the API, like metadata, to the map of strings terraform expects
+func stringMap(val map[string]interface{}) map[string]string {
+	m := make(map[string]string, len(val))
+	for key, value := range val {
+		if s, ok := value.(string); ok {
+			m[key] = s
+			continue
+		}
+		m[key] = fmt.Sprint(value)
+	}
+	return m
+}
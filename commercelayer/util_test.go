@@ -1,9 +1,12 @@
 package commercelayer
 
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/incentro-dc/go-commercelayer-sdk/api"
 	"github.com/stretchr/testify/assert"
+	"net/http"
 	"testing"
 )
 
@@ -103,3 +106,71 @@ func TestNestedMapFilledVal(t *testing.T) {
 		map[string]interface{}{"hello": "world"},
 	}))
 }
+
+func TestDiagErrForbidden(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":[{"title":"You are not authorized to perform this action."}]}`))
+	})
+	_, _, err := c.MarketsApi.GETMarkets(context.Background()).Execute()
+
+	diag := diagErr(err)
+	assert.True(t, diag.HasError())
+	assert.Contains(t, diag[0].Summary, "403 Forbidden")
+	assert.Contains(t, diag[0].Detail, "not permitted")
+}
+
+func TestDiagReadErrNotFound(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[{"title":"Record not found","status":"404"}]}`))
+	})
+	_, _, err := c.MarketsApi.GETMarketsMarketId(context.Background(), "removed").Execute()
+	assert.Equal(t, http.StatusNotFound, apiErrorStatus(err))
+
+	d := schema.TestResourceDataRaw(t, resourceMarket().Schema, map[string]interface{}{})
+	d.SetId("removed")
+
+	diag := diagReadErr(d, err)
+	assert.False(t, diag.HasError())
+	assert.Equal(t, "", d.Id())
+}
+
+func TestDiagReadErrForbidden(t *testing.T) {
+	c := testClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":[{"title":"You are not authorized to perform this action."}]}`))
+	})
+	_, _, err := c.MarketsApi.GETMarketsMarketId(context.Background(), "forbidden").Execute()
+	assert.Equal(t, http.StatusForbidden, apiErrorStatus(err))
+
+	d := schema.TestResourceDataRaw(t, resourceMarket().Schema, map[string]interface{}{})
+	d.SetId("forbidden")
+
+	diags := diagReadErr(d, err)
+	assert.False(t, diags.HasError())
+	assert.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Detail, "not permitted")
+	assert.Equal(t, "forbidden", d.Id())
+
+	diags = diagOptionalErr(err, "reading the tax rules")
+	assert.False(t, diags.HasError())
+	assert.Contains(t, diags[0].Summary, "skipped reading the tax rules")
+}
+
+func TestWaitForJob(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceImport().Schema, map[string]interface{}{})
+	d.SetId("vXYZabcDef")
+
+	diags := waitForJob(context.Background(), d, "import", func() (jobStatus, error) {
+		return jobStatus{status: "interrupted", errorsLog: `{"0":["name can't be blank"]}`}, nil
+	})
+
+	assert.True(t, diags.HasError())
+	assert.Equal(t, `import vXYZabcDef has been interrupted: {"0":["name can't be blank"]}`, diags[0].Summary)
+
+	diags = waitForJob(context.Background(), d, "import", func() (jobStatus, error) {
+		return jobStatus{status: "completed"}, nil
+	})
+	assert.False(t, diags.HasError())
+}
@@ -1,9 +1,12 @@
 package commercelayer
 
 import (
+	"errors"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/ladydascalie/currency"
+	"regexp"
+	"regexp/syntax"
 	"strings"
 )
 
@@ -55,3 +58,74 @@ var paymentSourceValidation = func(i interface{}, path cty.Path) diag.Diagnostic
 	return diag.Errorf("Invalid payment source provided: %s. Must be one of %s",
 		i.(string), strings.Join(getPaymentSources(), ", "))
 }
+
+func getKlarnaRegions() []string {
+	return []string{
+		"EU",
+		"US",
+		"OC",
+	}
+}
+
+var klarnaRegionValidation = func(i interface{}, path cty.Path) diag.Diagnostics {
+	for _, s := range getKlarnaRegions() {
+		if s == i.(string) {
+			return nil
+		}
+	}
+	return diag.Errorf("Invalid Klarna region provided: %s. Must be one of %s",
+		i.(string), strings.Join(getKlarnaRegions(), ", "))
+}
+
+// compileRegex compiles the regular expressions of shipping zones. Commercelayer evaluates them as Ruby regular
+// expressions, so patterns using syntax that Go does not support, like lookarounds, only result in a warning.
+func compileRegex(i interface{}, path cty.Path) (*regexp.Regexp, diag.Diagnostics) {
+	re, err := regexp.Compile(i.(string))
+	if err == nil {
+		return re, nil
+	}
+
+	var syntaxErr *syntax.Error
+	if errors.As(err, &syntaxErr) && syntaxErr.Code == syntax.ErrInvalidPerlOp {
+		return nil, diag.Diagnostics{{
+			Severity:      diag.Warning,
+			Summary:       "Regular expression can not be validated",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+
+	return nil, diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       "Invalid regular expression",
+		Detail:        err.Error(),
+		AttributePath: path,
+	}}
+}
+
+var regexValidation = func(i interface{}, path cty.Path) diag.Diagnostics {
+	_, diags := compileRegex(i, path)
+	return diags
+}
+
+var countryCodeRegexValidation = func(i interface{}, path cty.Path) diag.Diagnostics {
+	re, diags := compileRegex(i, path)
+	if re == nil {
+		return diags
+	}
+
+	for a := 'A'; a <= 'Z'; a++ {
+		for b := 'A'; b <= 'Z'; b++ {
+			if re.MatchString(string([]rune{a, b})) {
+				return nil
+			}
+		}
+	}
+
+	return diag.Diagnostics{{
+		Severity:      diag.Warning,
+		Summary:       "Regular expression matches no country code",
+		Detail:        "The pattern " + i.(string) + " does not match any 2-letter country code, e.g. NL.",
+		AttributePath: path,
+	}}
+}
@@ -15,6 +15,16 @@ func TestCurrencyCodeValidationOK(t *testing.T) {
 	assert.False(t, diag.HasError())
 }
 
+func TestInventoryModelStrategyValidationError(t *testing.T) {
+	diag := inventoryModelStrategyValidation("ship_from_nearest", nil)
+	assert.True(t, diag.HasError())
+}
+
+func TestInventoryModelStrategyValidationOK(t *testing.T) {
+	diag := inventoryModelStrategyValidation("split_shipments", nil)
+	assert.False(t, diag.HasError())
+}
+
 func TestPaymentSourceValidationError(t *testing.T) {
 	diag := paymentSourceValidation("Adyen", nil)
 	assert.True(t, diag.HasError())
@@ -24,3 +34,41 @@ func TestPaymentSourceValidationOK(t *testing.T) {
 	diag := paymentSourceValidation("BraintreePayment", nil)
 	assert.False(t, diag.HasError())
 }
+
+func TestKlarnaRegionValidationError(t *testing.T) {
+	diag := klarnaRegionValidation("NL", nil)
+	assert.True(t, diag.HasError())
+}
+
+func TestKlarnaRegionValidationOK(t *testing.T) {
+	diag := klarnaRegionValidation("US", nil)
+	assert.False(t, diag.HasError())
+}
+
+func TestRegexValidationOK(t *testing.T) {
+	diag := regexValidation("^(1|2)[0-9]{3}$", nil)
+	assert.False(t, diag.HasError())
+	assert.Empty(t, diag)
+}
+
+func TestRegexValidationError(t *testing.T) {
+	diag := regexValidation("^(1|2[0-9]{3}$", nil)
+	assert.True(t, diag.HasError())
+}
+
+func TestRegexValidationUnsupportedSyntax(t *testing.T) {
+	diag := regexValidation("^(?!9)[0-9]{4}$", nil)
+	assert.False(t, diag.HasError())
+	assert.Len(t, diag, 1)
+}
+
+func TestCountryCodeRegexValidationOK(t *testing.T) {
+	diag := countryCodeRegexValidation("^(NL|BE)$", nil)
+	assert.Empty(t, diag)
+}
+
+func TestCountryCodeRegexValidationMatchesNothing(t *testing.T) {
+	diag := countryCodeRegexValidation("^(nl|be)$", nil)
+	assert.False(t, diag.HasError())
+	assert.Len(t, diag, 1)
+}
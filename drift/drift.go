@@ -0,0 +1,202 @@
+// Package drift checks the Commercelayer resources recorded in a terraform state file against the API, without
+// running terraform. It is meant for scheduled audits of resources that have been removed or changed out of band.
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const (
+	// StatusExists means the resource could still be read from the API
+	StatusExists = "exists"
+	// StatusDeleted means the resource is in the state but no longer in the API
+	StatusDeleted = "deleted"
+	// StatusChanged means the resource still exists, but attributes refreshed by the provider differ from the state
+	StatusChanged = "changed"
+	// StatusError means the resource could not be read, e.g. because of missing permissions
+	StatusError = "error"
+)
+
+// Entry is the drift status of a single resource instance of the state
+type Entry struct {
+	Address string `json:"address"`
+	Type    string `json:"type"`
+	ID      string `json:"id"`
+	Status  string `json:"status"`
+	// Changes are the top level attributes that differ from the state when the status is StatusChanged
+	Changes []string `json:"changes,omitempty"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// state is the subset of the terraform state file format used by the report
+type state struct {
+	Version   int `json:"version"`
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey      interface{}     `json:"index_key"`
+			SchemaVersion int             `json:"schema_version"`
+			Attributes    json.RawMessage `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+// Report reads every resource of a state file that is managed by the given, configured provider and returns its
+// drift status. The resources are read the way terraform refreshes them, starting from their attributes in the state,
+// so only the attributes that the read functions of the provider refresh can be reported as changed.
+func Report(ctx context.Context, p *schema.Provider, stateFile io.Reader) ([]Entry, error) {
+	var s state
+	if err := json.NewDecoder(stateFile).Decode(&s); err != nil {
+		return nil, fmt.Errorf("failed to parse state file: %w", err)
+	}
+	if s.Version != 4 {
+		return nil, fmt.Errorf("unsupported state file version %d", s.Version)
+	}
+
+	var entries []Entry
+	for _, r := range s.Resources {
+		resource, ok := p.ResourcesMap[r.Type]
+		if r.Mode != "managed" || !ok {
+			continue
+		}
+
+		for _, instance := range r.Instances {
+			var attributes struct {
+				ID string `json:"id"`
+			}
+			_ = json.Unmarshal(instance.Attributes, &attributes)
+
+			entry := Entry{
+				Address: address(r.Module, r.Type, r.Name, instance.IndexKey),
+				Type:    r.Type,
+				ID:      attributes.ID,
+				Status:  StatusExists,
+			}
+
+			err := check(ctx, p, resource, instance.Attributes, instance.SchemaVersion, &entry)
+			if err != nil {
+				entry.Status = StatusError
+				entry.Error = err.Error()
+			}
+
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, nil
+}
+
+// check reads a resource instance through the provider and sets the status of its entry
+func check(ctx context.Context, p *schema.Provider, resource *schema.Resource, attributes json.RawMessage, schemaVersion int, entry *Entry) error {
+	value, err := ctyjson.Unmarshal(attributes, resource.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		return fmt.Errorf("failed to decode the state of %s: %w", entry.Address, err)
+	}
+
+	prior := resource.Data(terraform.NewInstanceStateShimmedFromValue(value, schemaVersion)).State()
+	if prior == nil {
+		return fmt.Errorf("%s has no id in the state", entry.Address)
+	}
+
+	d := resource.Data(prior.DeepCopy())
+	// the read functions only warn when the credentials are not permitted to refresh a resource, which leaves its
+	// status unknown as much as an error does
+	diags := resource.ReadContext(ctx, d, p.Meta())
+	if len(diags) > 0 {
+		return fmt.Errorf("%s", diags[0].Summary)
+	}
+
+	refreshed := d.State()
+	if refreshed == nil {
+		entry.Status = StatusDeleted
+		return nil
+	}
+
+	entry.Changes = changes(prior.Attributes, refreshed.Attributes)
+	if len(entry.Changes) > 0 {
+		entry.Status = StatusChanged
+	}
+
+	return nil
+}
+
+// changes returns the sorted top level attributes whose flattened values differ between the prior and refreshed state
+func changes(prior, refreshed map[string]string) []string {
+	changed := map[string]bool{}
+	for key, value := range prior {
+		if refreshedValue, ok := refreshed[key]; !ok || refreshedValue != value {
+			changed[strings.SplitN(key, ".", 2)[0]] = true
+		}
+	}
+	for key := range refreshed {
+		if _, ok := prior[key]; !ok {
+			changed[strings.SplitN(key, ".", 2)[0]] = true
+		}
+	}
+
+	var attributes []string
+	for attribute := range changed {
+		attributes = append(attributes, attribute)
+	}
+	sort.Strings(attributes)
+
+	return attributes
+}
+
+// WriteJSON writes the report entries as a JSON array
+func WriteJSON(w io.Writer, entries []Entry) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if entries == nil {
+		entries = []Entry{}
+	}
+	return encoder.Encode(entries)
+}
+
+// WriteMarkdown writes the report entries as a markdown table
+func WriteMarkdown(w io.Writer, entries []Entry) error {
+	var b strings.Builder
+	b.WriteString("| Address | ID | Status |\n")
+	b.WriteString("|---------|----|--------|\n")
+	for _, e := range entries {
+		status := e.Status
+		if len(e.Changes) > 0 {
+			status = fmt.Sprintf("%s: %s", e.Status, strings.Join(e.Changes, ", "))
+		}
+		if e.Error != "" {
+			status = fmt.Sprintf("%s: %s", e.Status, e.Error)
+		}
+		b.WriteString(fmt.Sprintf("| %s | %s | %s |\n", e.Address, e.ID, strings.ReplaceAll(status, "|", "\\|")))
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func address(module, resourceType, name string, indexKey interface{}) string {
+	addr := fmt.Sprintf("%s.%s", resourceType, name)
+	if module != "" {
+		addr = fmt.Sprintf("%s.%s", module, addr)
+	}
+
+	switch key := indexKey.(type) {
+	case string:
+		addr = fmt.Sprintf("%s[%q]", addr, key)
+	case float64:
+		addr = fmt.Sprintf("%s[%d]", addr, int(key))
+	}
+
+	return addr
+}
@@ -0,0 +1,165 @@
+package drift
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/incentro-dc/go-commercelayer-sdk/api"
+	"github.com/incentro-dc/terraform-provider-commercelayer/commercelayer"
+	"github.com/stretchr/testify/assert"
+)
+
+const testState = `{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed",
+      "type": "commercelayer_market",
+      "name": "eu",
+      "instances": [{"attributes": {"id": "present"}}]
+    },
+    {
+      "module": "module.shop",
+      "mode": "managed",
+      "type": "commercelayer_market",
+      "name": "us",
+      "instances": [{"index_key": 0, "attributes": {"id": "removed"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "commercelayer_market",
+      "name": "uk",
+      "instances": [{"index_key": "uk", "attributes": {"id": "forbidden"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "commercelayer_market",
+      "name": "ch",
+      "instances": [{"attributes": {"id": "renamed", "name": "Switzerland"}}]
+    },
+    {
+      "mode": "managed",
+      "type": "other_resource",
+      "name": "ignored",
+      "instances": [{"attributes": {"id": "ignored"}}]
+    }
+  ]
+}`
+
+func testProvider() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"commercelayer_market": {
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+				ReadContext: func(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+					switch d.Id() {
+					case "removed":
+						d.SetId("")
+					case "forbidden":
+						return diag.Errorf("403 Forbidden")
+					case "renamed":
+						return diag.FromErr(d.Set("name", "Confoederatio Helvetica"))
+					}
+					return nil
+				},
+			},
+		},
+	}
+}
+
+func TestReport(t *testing.T) {
+	entries, err := Report(context.Background(), testProvider(), strings.NewReader(testState))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []Entry{
+		{Address: "commercelayer_market.eu", Type: "commercelayer_market", ID: "present", Status: StatusExists},
+		{Address: "module.shop.commercelayer_market.us[0]", Type: "commercelayer_market", ID: "removed", Status: StatusDeleted},
+		{Address: "commercelayer_market.uk[\"uk\"]", Type: "commercelayer_market", ID: "forbidden", Status: StatusError, Error: "403 Forbidden"},
+		{Address: "commercelayer_market.ch", Type: "commercelayer_market", ID: "renamed", Status: StatusChanged, Changes: []string{"name"}},
+	}, entries)
+}
+
+func TestReportProviderResources(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch r.URL.Path {
+		case "/markets/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = io.WriteString(w, `{"errors": [{"title": "You are not authorized to perform this action."}]}`)
+		case "/markets/disabled":
+			_, _ = io.WriteString(w, `{"data": {"id": "disabled", "type": "markets", "attributes": {
+				"name": "Europe", "shared_secret": "secret", "disabled_at": "2023-03-28T08:12:20.702Z"
+			}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"errors": [{"title": "Record not found", "status": "404"}]}`)
+		}
+	}))
+	defer server.Close()
+
+	p := commercelayer.Provider()()
+	p.SetMeta(api.NewAPIClient(&api.Configuration{Servers: []api.ServerConfiguration{{URL: server.URL}}}))
+
+	entries, err := Report(context.Background(), p, strings.NewReader(`{
+	  "version": 4,
+	  "resources": [
+		{
+		  "mode": "managed",
+		  "type": "commercelayer_market",
+		  "name": "eu",
+		  "instances": [{"attributes": {"id": "disabled", "shared_secret": "secret", "disabled_at": ""}}]
+		},
+		{
+		  "mode": "managed",
+		  "type": "commercelayer_market",
+		  "name": "us",
+		  "instances": [{"attributes": {"id": "removed", "shared_secret": "secret", "disabled_at": ""}}]
+		},
+		{
+		  "mode": "managed",
+		  "type": "commercelayer_market",
+		  "name": "uk",
+		  "instances": [{"attributes": {"id": "forbidden", "shared_secret": "secret", "disabled_at": ""}}]
+		}
+	  ]
+	}`))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []Entry{
+		{Address: "commercelayer_market.eu", Type: "commercelayer_market", ID: "disabled", Status: StatusChanged, Changes: []string{"disabled_at"}},
+		{Address: "commercelayer_market.us", Type: "commercelayer_market", ID: "removed", Status: StatusDeleted},
+		{Address: "commercelayer_market.uk", Type: "commercelayer_market", ID: "forbidden", Status: StatusError,
+			Error: "forbidden has not been refreshed: 403 Forbidden"},
+	}, entries)
+}
+
+func TestReportUnsupportedVersion(t *testing.T) {
+	_, err := Report(context.Background(), testProvider(), strings.NewReader(`{"version": 3}`))
+	assert.Error(t, err)
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	var b bytes.Buffer
+	err := WriteMarkdown(&b, []Entry{
+		{Address: "commercelayer_market.us", ID: "removed", Status: StatusDeleted},
+		{Address: "commercelayer_market.eu", ID: "disabled", Status: StatusChanged, Changes: []string{"disabled_at"}},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "| Address | ID | Status |\n"+
+		"|---------|----|--------|\n"+
+		"| commercelayer_market.us | removed | deleted |\n"+
+		"| commercelayer_market.eu | disabled | changed: disabled_at |\n", b.String())
+}
@@ -0,0 +1,13 @@
+data "commercelayer_address" "headquarters" {
+  reference = "ERP-ADDR-0001"
+}
+
+resource "commercelayer_merchant" "incentro" {
+  attributes {
+    name = "Incentro"
+  }
+
+  relationships {
+    address_id = data.commercelayer_address.headquarters.id
+  }
+}
\ No newline at end of file
@@ -0,0 +1,8 @@
+data "commercelayer_application" "current" {
+  lifecycle {
+    postcondition {
+      condition     = self.organization_slug == "incentro-staging" && self.mode == "test"
+      error_message = "The credentials don't belong to the test mode of the incentro-staging organization."
+    }
+  }
+}
\ No newline at end of file
@@ -0,0 +1,14 @@
+data "commercelayer_sku" "tshirt" {
+  code = "TSHIRT-M"
+}
+
+data "commercelayer_attachments" "tshirt" {
+  attachable_id = data.commercelayer_sku.tshirt.id
+
+  lifecycle {
+    postcondition {
+      condition     = contains(self.names, "CE certificate")
+      error_message = "The t-shirt is missing its CE certificate."
+    }
+  }
+}
\ No newline at end of file
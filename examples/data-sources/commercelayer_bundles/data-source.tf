@@ -0,0 +1,8 @@
+data "commercelayer_bundles" "tshirts" {
+  code      = "BUNDLE-TSHIRTS"
+  market_id = "vjzmJhvEDo"
+}
+
+output "tshirts_bundle_id" {
+  value = one(data.commercelayer_bundles.tshirts.bundles[*].id)
+}
\ No newline at end of file
@@ -0,0 +1,7 @@
+data "commercelayer_carrier_accounts" "nl" {
+  market_id = "vjzmJhvEDo"
+}
+
+output "nl_carriers" {
+  value = { for account in data.commercelayer_carrier_accounts.nl.carrier_accounts : account.name => account.id }
+}
\ No newline at end of file
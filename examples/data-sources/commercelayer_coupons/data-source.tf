@@ -0,0 +1,8 @@
+data "commercelayer_coupons" "summer_campaign" {
+  promotion_rule_id = "qNbZpRvWxA"
+  code_prefix       = "SUMMER-"
+}
+
+output "summer_campaign_unused_codes" {
+  value = [for coupon in data.commercelayer_coupons.summer_campaign.coupons : coupon.code if coupon.usage_count == 0]
+}
\ No newline at end of file
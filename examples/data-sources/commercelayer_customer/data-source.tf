@@ -0,0 +1,15 @@
+data "commercelayer_customer" "tester" {
+  email = "tester@example.com"
+}
+
+resource "commercelayer_in_stock_subscription" "tester_tshirt" {
+  attributes {
+    stock_threshold = 1
+  }
+
+  relationships {
+    market_id   = commercelayer_market.europe.id
+    customer_id = data.commercelayer_customer.tester.id
+    sku_id      = commercelayer_sku.tshirt.id
+  }
+}
\ No newline at end of file
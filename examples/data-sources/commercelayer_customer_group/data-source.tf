@@ -0,0 +1,16 @@
+data "commercelayer_customer_group" "vip" {
+  reference = "CRM-VIP"
+}
+
+resource "commercelayer_market" "vip" {
+  attributes {
+    name = "VIP"
+  }
+
+  relationships {
+    merchant_id        = commercelayer_merchant.incentro.id
+    price_list_id      = commercelayer_price_list.eur.id
+    inventory_model_id = commercelayer_inventory_model.incentro.id
+    customer_group_id  = data.commercelayer_customer_group.vip.id
+  }
+}
\ No newline at end of file
@@ -0,0 +1,10 @@
+data "commercelayer_delivery_lead_times" "amsterdam" {
+  stock_location_id = data.commercelayer_stock_location.amsterdam.id
+}
+
+output "amsterdam_delivery_slas" {
+  value = {
+    for lead_time in data.commercelayer_delivery_lead_times.amsterdam.delivery_lead_times :
+    lead_time.shipping_method_id => "${lead_time.min_days}-${lead_time.max_days} days"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,12 @@
+data "commercelayer_export" "orders" {
+  reference     = "nightly-orders"
+  resource_type = "orders"
+}
+
+output "orders_export_url" {
+  value = data.commercelayer_export.orders.attachment_url
+}
+
+output "orders_export_records_count" {
+  value = data.commercelayer_export.orders.records_count
+}
\ No newline at end of file
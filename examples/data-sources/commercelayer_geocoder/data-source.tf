@@ -0,0 +1,20 @@
+data "commercelayer_geocoder" "shared" {
+  name = "Shared geocoder"
+}
+
+resource "commercelayer_address" "warehouse" {
+  attributes {
+    business     = true
+    company      = "Incentro"
+    line_1       = "Van Nelleweg 1"
+    zip_code     = "3044 BC"
+    country_code = "NL"
+    city         = "Rotterdam"
+    phone        = "+31(0)10 20 20 544"
+    state_code   = "ZH"
+  }
+
+  relationships {
+    geocoder_id = data.commercelayer_geocoder.shared.id
+  }
+}
\ No newline at end of file
@@ -0,0 +1,8 @@
+data "commercelayer_gift_cards" "active" {
+  market_id = "vjzmJhvEDo"
+  status    = "active"
+}
+
+output "outstanding_gift_card_balance_cents" {
+  value = sum(concat([0], [for gift_card in data.commercelayer_gift_cards.active.gift_cards : gift_card.balance_cents]))
+}
\ No newline at end of file
@@ -0,0 +1,14 @@
+variable "nightly_sku_import_reference" {
+  type = string
+}
+
+data "commercelayer_import" "nightly_skus" {
+  reference = var.nightly_sku_import_reference
+
+  lifecycle {
+    postcondition {
+      condition     = self.status == "completed" && self.errors_count == 0
+      error_message = "The nightly SKU import did not complete cleanly: ${self.errors_log}"
+    }
+  }
+}
\ No newline at end of file
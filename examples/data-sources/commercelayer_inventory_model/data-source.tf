@@ -0,0 +1,15 @@
+data "commercelayer_inventory_model" "shared" {
+  name = "Shared Inventory"
+}
+
+resource "commercelayer_market" "europe" {
+  attributes {
+    name = "Europe"
+  }
+
+  relationships {
+    merchant_id        = commercelayer_merchant.incentro.id
+    price_list_id      = commercelayer_price_list.eur.id
+    inventory_model_id = data.commercelayer_inventory_model.shared.id
+  }
+}
\ No newline at end of file
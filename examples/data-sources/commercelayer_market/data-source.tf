@@ -0,0 +1,13 @@
+data "commercelayer_market" "europe" {
+  name = "Europe"
+}
+
+resource "commercelayer_billing_info_validation_rule" "europe" {
+  relationships {
+    market_id = data.commercelayer_market.europe.id
+  }
+}
+
+output "europe_price_list_id" {
+  value = data.commercelayer_market.europe.price_list_id
+}
\ No newline at end of file
@@ -0,0 +1,11 @@
+data "commercelayer_markets" "enabled" {
+  disabled = false
+}
+
+resource "commercelayer_billing_info_validation_rule" "per_market" {
+  for_each = { for market in data.commercelayer_markets.enabled.markets : market.name => market.id }
+
+  relationships {
+    market_id = each.value
+  }
+}
\ No newline at end of file
@@ -0,0 +1,18 @@
+# Without id or name, the only merchant of the organization is returned
+data "commercelayer_merchant" "default" {}
+
+resource "commercelayer_market" "europe" {
+  attributes {
+    name = "Europe"
+  }
+
+  relationships {
+    merchant_id        = data.commercelayer_merchant.default.id
+    price_list_id      = commercelayer_price_list.eur.id
+    inventory_model_id = commercelayer_inventory_model.incentro.id
+  }
+}
+
+output "merchant_country" {
+  value = data.commercelayer_merchant.default.address[0].country_code
+}
\ No newline at end of file
@@ -0,0 +1,13 @@
+data "commercelayer_organization" "current" {}
+
+resource "commercelayer_webhook" "orders_placed" {
+  attributes {
+    name         = "orders placed"
+    topic        = "orders.place"
+    callback_url = "https://hooks.example.com/${data.commercelayer_organization.current.slug}/orders"
+  }
+}
+
+output "is_live" {
+  value = data.commercelayer_organization.current.mode == "live"
+}
\ No newline at end of file
@@ -0,0 +1,11 @@
+data "commercelayer_stock_location" "amsterdam" {
+  name = "Amsterdam warehouse"
+}
+
+data "commercelayer_packages" "amsterdam" {
+  stock_location_id = data.commercelayer_stock_location.amsterdam.id
+}
+
+output "amsterdam_package_codes" {
+  value = data.commercelayer_packages.amsterdam.packages[*].code
+}
\ No newline at end of file
@@ -0,0 +1,19 @@
+data "commercelayer_payment_gateway" "default" {
+  name = "Default Gateway"
+}
+
+resource "commercelayer_payment_method" "credit_card" {
+  attributes {
+    payment_source_type = "CreditCard"
+    currency_code       = "EUR"
+    price_amount_cents  = 0
+  }
+
+  relationships {
+    payment_gateway_id = data.commercelayer_payment_gateway.default.id
+  }
+}
+
+output "default_gateway_type" {
+  value = data.commercelayer_payment_gateway.default.type
+}
\ No newline at end of file
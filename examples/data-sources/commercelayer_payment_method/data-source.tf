@@ -0,0 +1,8 @@
+data "commercelayer_payment_method" "europe_stripe" {
+  payment_source_type = "StripePayment"
+  market_id           = data.commercelayer_market.europe.id
+}
+
+output "europe_stripe_gateway" {
+  value = "${data.commercelayer_payment_method.europe_stripe.payment_gateway_type}/${data.commercelayer_payment_method.europe_stripe.payment_gateway_id}"
+}
\ No newline at end of file
@@ -0,0 +1,16 @@
+data "commercelayer_price_list" "catalog_eur" {
+  name          = "Catalog EUR"
+  currency_code = "EUR"
+}
+
+resource "commercelayer_market" "europe" {
+  attributes {
+    name = "Europe"
+  }
+
+  relationships {
+    merchant_id        = commercelayer_merchant.incentro.id
+    price_list_id      = data.commercelayer_price_list.catalog_eur.id
+    inventory_model_id = commercelayer_inventory_model.incentro.id
+  }
+}
\ No newline at end of file
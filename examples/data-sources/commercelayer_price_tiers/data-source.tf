@@ -0,0 +1,10 @@
+data "commercelayer_price_tiers" "tshirt" {
+  price_id = "xYZkjABcde"
+
+  lifecycle {
+    postcondition {
+      condition     = [for tier in self.price_tiers : tier.up_to] == [10, 50, 0]
+      error_message = "The t-shirt price does not have the intended volume tiers."
+    }
+  }
+}
\ No newline at end of file
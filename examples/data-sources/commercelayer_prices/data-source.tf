@@ -0,0 +1,9 @@
+data "commercelayer_prices" "tshirt" {
+  sku_code = "TSHIRT-M"
+}
+
+output "tshirt_prices" {
+  value = {
+    for price in data.commercelayer_prices.tshirt.prices : price.currency_code => price.amount_cents
+  }
+}
\ No newline at end of file
@@ -0,0 +1,8 @@
+data "commercelayer_promotions" "europe_active" {
+  status    = "active"
+  market_id = data.commercelayer_market.europe.id
+}
+
+output "europe_active_promotions" {
+  value = [for promotion in data.commercelayer_promotions.europe_active.promotions : promotion.name]
+}
\ No newline at end of file
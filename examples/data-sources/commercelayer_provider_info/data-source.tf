@@ -0,0 +1,5 @@
+data "commercelayer_provider_info" "this" {}
+
+output "commercelayer_provider_version" {
+  value = data.commercelayer_provider_info.this.provider_version
+}
@@ -0,0 +1,14 @@
+data "commercelayer_shipping_category" "apparel" {
+  name = "Apparel"
+}
+
+resource "commercelayer_sku" "tshirt" {
+  attributes {
+    code = "TSHIRT-M"
+    name = "T-shirt M"
+  }
+
+  relationships {
+    shipping_category_id = data.commercelayer_shipping_category.apparel.id
+  }
+}
\ No newline at end of file
@@ -0,0 +1,16 @@
+data "commercelayer_shipping_method" "express" {
+  name      = "Express Delivery"
+  market_id = commercelayer_market.europe.id
+}
+
+resource "commercelayer_delivery_lead_time" "express_amsterdam" {
+  attributes {
+    min_hours = 10
+    max_hours = 24
+  }
+
+  relationships {
+    shipping_method_id = data.commercelayer_shipping_method.express.id
+    stock_location_id  = commercelayer_stock_location.amsterdam.id
+  }
+}
\ No newline at end of file
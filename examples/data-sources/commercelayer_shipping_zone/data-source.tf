@@ -0,0 +1,16 @@
+data "commercelayer_shipping_zone" "benelux" {
+  name = "Benelux"
+}
+
+resource "commercelayer_shipping_method" "standard" {
+  attributes {
+    name               = "Standard Shipping"
+    scheme             = "flat"
+    currency_code      = "EUR"
+    price_amount_cents = 500
+  }
+
+  relationships {
+    shipping_zone_id = data.commercelayer_shipping_zone.benelux.id
+  }
+}
\ No newline at end of file
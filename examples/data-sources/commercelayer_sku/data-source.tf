@@ -0,0 +1,7 @@
+data "commercelayer_sku" "tshirt" {
+  code = "INCENTRO-TSHIRT-M"
+}
+
+output "tshirt_shipping_category_id" {
+  value = data.commercelayer_sku.tshirt.shipping_category_id
+}
\ No newline at end of file
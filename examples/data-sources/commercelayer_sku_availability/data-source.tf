@@ -0,0 +1,8 @@
+data "commercelayer_sku_availability" "hero_product" {
+  sku_id    = "xYZkjABcde"
+  market_id = commercelayer_market.incentro_market.id
+}
+
+output "hero_product_available" {
+  value = data.commercelayer_sku_availability.hero_product.available
+}
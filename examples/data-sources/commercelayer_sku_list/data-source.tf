@@ -0,0 +1,7 @@
+data "commercelayer_sku_list" "summer_sale" {
+  slug = "summer-sale"
+}
+
+output "summer_sale_sku_list_id" {
+  value = data.commercelayer_sku_list.summer_sale.id
+}
\ No newline at end of file
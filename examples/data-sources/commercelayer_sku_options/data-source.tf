@@ -0,0 +1,7 @@
+data "commercelayer_sku_options" "nl" {
+  market_id = "vjzmJhvEDo"
+}
+
+output "nl_sku_option_prices" {
+  value = { for option in data.commercelayer_sku_options.nl.sku_options : option.name => option.price_amount_cents }
+}
\ No newline at end of file
@@ -0,0 +1,19 @@
+data "commercelayer_skus" "preorder" {
+  code_prefix = "PREORDER-"
+
+  filter {
+    attribute = "created_at"
+    predicate = "gteq"
+    values    = ["2023-01-01T00:00:00Z"]
+  }
+}
+
+resource "commercelayer_sku_option" "preorder_delay" {
+  for_each = { for sku in data.commercelayer_skus.preorder.skus : sku.code => sku }
+
+  attributes {
+    name           = "Preorder ${each.key}"
+    delay_hours    = 336
+    sku_code_regex = "^${each.key}$"
+  }
+}
\ No newline at end of file
@@ -0,0 +1,22 @@
+data "commercelayer_stock_items" "tshirt" {
+  sku_code = "TSHIRT-M"
+}
+
+resource "commercelayer_market" "nordics" {
+  attributes {
+    name = "Nordics"
+  }
+
+  relationships {
+    merchant_id        = commercelayer_merchant.incentro.id
+    price_list_id      = commercelayer_price_list.eur.id
+    inventory_model_id = commercelayer_inventory_model.incentro.id
+  }
+
+  lifecycle {
+    precondition {
+      condition     = data.commercelayer_stock_items.tshirt.total_quantity >= 100
+      error_message = "Not enough t-shirts in stock to open the Nordics market."
+    }
+  }
+}
\ No newline at end of file
@@ -0,0 +1,18 @@
+data "commercelayer_stock_location" "warehouse" {
+  reference = "WMS-AMS-01"
+}
+
+resource "commercelayer_inventory_stock_location" "warehouse" {
+  attributes {
+    priority = 1
+  }
+
+  relationships {
+    stock_location_id  = data.commercelayer_stock_location.warehouse.id
+    inventory_model_id = commercelayer_inventory_model.incentro.id
+  }
+}
+
+output "warehouse_address_id" {
+  value = data.commercelayer_stock_location.warehouse.address_id
+}
\ No newline at end of file
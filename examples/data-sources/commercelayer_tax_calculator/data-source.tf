@@ -0,0 +1,16 @@
+data "commercelayer_tax_calculator" "europe" {
+  name = "Europe Taxes"
+}
+
+resource "commercelayer_market" "europe" {
+  attributes {
+    name = "Europe"
+  }
+
+  relationships {
+    merchant_id        = commercelayer_merchant.incentro.id
+    price_list_id      = commercelayer_price_list.eur.id
+    inventory_model_id = commercelayer_inventory_model.incentro.id
+    tax_calculator_id  = data.commercelayer_tax_calculator.europe.id
+  }
+}
\ No newline at end of file
@@ -0,0 +1,11 @@
+data "commercelayer_tax_calculator" "avalara" {
+  name = "Avalara EU"
+}
+
+data "commercelayer_tax_categories" "avalara" {
+  tax_calculator_id = data.commercelayer_tax_calculator.avalara.id
+}
+
+output "avalara_tax_codes" {
+  value = { for category in data.commercelayer_tax_categories.avalara.tax_categories : category.sku_code => category.code }
+}
\ No newline at end of file
@@ -0,0 +1,13 @@
+data "commercelayer_webhook" "orders_placed" {
+  topic        = "orders.place"
+  callback_url = "https://erp.example.com/commercelayer/orders"
+}
+
+output "orders_placed_circuit_state" {
+  value = data.commercelayer_webhook.orders_placed.circuit_state
+}
+
+output "orders_placed_shared_secret" {
+  value     = data.commercelayer_webhook.orders_placed.shared_secret
+  sensitive = true
+}
\ No newline at end of file
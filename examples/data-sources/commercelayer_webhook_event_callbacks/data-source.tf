@@ -0,0 +1,12 @@
+data "commercelayer_webhook" "orders_placed" {
+  topic = "orders.place"
+}
+
+data "commercelayer_webhook_event_callbacks" "orders_placed" {
+  webhook_id = data.commercelayer_webhook.orders_placed.id
+  page_size  = 25
+}
+
+output "orders_placed_failed_deliveries" {
+  value = data.commercelayer_webhook_event_callbacks.orders_placed.failures_count
+}
\ No newline at end of file
@@ -0,0 +1,5 @@
+resource "commercelayer_billing_info_validation_rule" "incentro_billing_info_validation_rule" {
+  relationships {
+    market_id = commercelayer_market.incentro_market.id
+  }
+}
@@ -0,0 +1,8 @@
+resource "commercelayer_cleanup" "incentro_cleanup" {
+  attributes {
+    resource_type = "addresses"
+    filters = jsonencode({
+      reference_origin_eq = "terraform-sandbox"
+    })
+  }
+}
@@ -0,0 +1,12 @@
+resource "commercelayer_import" "incentro_import" {
+  attributes {
+    resource_type      = "prices"
+    parent_resource_id = commercelayer_price_list.incentro_price_list.id
+    inputs = jsonencode([
+      {
+        sku_code     = "TSHIRTMM000000FFFFFFXLXX"
+        amount_cents = 1000
+      }
+    ])
+  }
+}
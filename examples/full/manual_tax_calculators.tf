@@ -2,4 +2,17 @@ resource "commercelayer_manual_tax_calculator" "incentro_manual_tax_calculator"
   attributes {
     name = "Incentro Manual Tax Calculator"
   }
+
+  tax_rule {
+    name               = "NL high"
+    tax_rate           = 0.21
+    country_code_regex = "^NL$"
+    freight_taxable    = true
+  }
+
+  tax_rule {
+    name               = "NL low"
+    tax_rate           = 0.09
+    country_code_regex = "^NL$"
+  }
 }
\ No newline at end of file
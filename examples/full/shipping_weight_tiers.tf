@@ -0,0 +1,26 @@
+resource "commercelayer_shipping_method" "incentro_weight_tiered_shipping_method" {
+  attributes {
+    name               = "Incentro Weight Tiered Shipping Method"
+    scheme             = "weight_tiered"
+    currency_code      = "EUR"
+    price_amount_cents = 1000
+    unit_of_weight     = "kg"
+  }
+
+  relationships {
+    market_id        = commercelayer_market.incentro_market.id
+    shipping_zone_id = commercelayer_shipping_zone.incentro_shipping_zone.id
+  }
+}
+
+resource "commercelayer_shipping_weight_tier" "incentro_shipping_weight_tier" {
+  attributes {
+    name               = "Incentro Shipping Weight Tier"
+    up_to              = 5
+    price_amount_cents = 500
+  }
+
+  relationships {
+    shipping_method_id = commercelayer_shipping_method.incentro_weight_tiered_shipping_method.id
+  }
+}
@@ -0,0 +1,13 @@
+resource "commercelayer_sku_option" "incentro_sku_option" {
+  attributes {
+    name               = "Incentro Engraving"
+    currency_code      = "EUR"
+    price_amount_cents = 500
+    delay_hours        = 48
+    sku_code_regex     = "^INCENTRO-TSHIRT"
+  }
+
+  relationships {
+    market_id = commercelayer_market.incentro_market.id
+  }
+}
\ No newline at end of file
@@ -0,0 +1,102 @@
+terraform {
+  required_providers {
+    commercelayer = {
+      version = ">= 0.0.1"
+      source  = "incentro-dc/commercelayer"
+    }
+  }
+}
+
+resource "commercelayer_address" "this" {
+  attributes {
+    business     = true
+    company      = var.address.company
+    line_1       = var.address.line_1
+    zip_code     = var.address.zip_code
+    country_code = var.country_code
+    city         = var.address.city
+    phone        = var.address.phone
+    state_code   = var.address.state_code
+  }
+}
+
+resource "commercelayer_merchant" "this" {
+  attributes {
+    name = "${var.name} Merchant"
+  }
+
+  relationships {
+    address_id = commercelayer_address.this.id
+  }
+}
+
+resource "commercelayer_price_list" "this" {
+  attributes {
+    name          = "${var.name} Price List"
+    currency_code = var.currency_code
+  }
+}
+
+resource "commercelayer_stock_location" "this" {
+  attributes {
+    name = "${var.name} Warehouse"
+  }
+
+  relationships {
+    address_id = commercelayer_address.this.id
+  }
+}
+
+resource "commercelayer_inventory_model" "this" {
+  attributes {
+    name     = "${var.name} Inventory Model"
+    strategy = "no_split"
+  }
+}
+
+resource "commercelayer_inventory_stock_location" "this" {
+  attributes {
+    priority = 1
+  }
+
+  relationships {
+    inventory_model_id = commercelayer_inventory_model.this.id
+    stock_location_id  = commercelayer_stock_location.this.id
+  }
+}
+
+resource "commercelayer_market" "this" {
+  attributes {
+    name = var.name
+  }
+
+  relationships {
+    inventory_model_id = commercelayer_inventory_model.this.id
+    merchant_id        = commercelayer_merchant.this.id
+    price_list_id      = commercelayer_price_list.this.id
+  }
+
+  # A market without stock locations can not sell anything
+  depends_on = [commercelayer_inventory_stock_location.this]
+}
+
+resource "commercelayer_manual_gateway" "this" {
+  count = var.payment_gateway_id == null ? 1 : 0
+
+  attributes {
+    name = "${var.name} Manual Gateway"
+  }
+}
+
+resource "commercelayer_payment_method" "this" {
+  attributes {
+    payment_source_type = var.payment_source_type
+    currency_code       = var.currency_code
+    price_amount_cents  = 0
+  }
+
+  relationships {
+    market_id          = commercelayer_market.this.id
+    payment_gateway_id = coalesce(var.payment_gateway_id, one(commercelayer_manual_gateway.this[*].id))
+  }
+}
@@ -0,0 +1,23 @@
+output "market_id" {
+  value = commercelayer_market.this.id
+}
+
+output "merchant_id" {
+  value = commercelayer_merchant.this.id
+}
+
+output "price_list_id" {
+  value = commercelayer_price_list.this.id
+}
+
+output "inventory_model_id" {
+  value = commercelayer_inventory_model.this.id
+}
+
+output "stock_location_id" {
+  value = commercelayer_stock_location.this.id
+}
+
+output "payment_method_id" {
+  value = commercelayer_payment_method.this.id
+}
@@ -0,0 +1,38 @@
+variable "name" {
+  description = "The name of the sales channel, used as market name and as prefix for the other resources"
+  type        = string
+}
+
+variable "country_code" {
+  description = "The country code of the merchant and warehouse address"
+  type        = string
+}
+
+variable "currency_code" {
+  description = "The currency of the price list and payment method"
+  type        = string
+}
+
+variable "address" {
+  description = "The business address of the merchant, also used for the warehouse"
+  type = object({
+    company    = string
+    line_1     = string
+    zip_code   = string
+    city       = string
+    phone      = string
+    state_code = string
+  })
+}
+
+variable "payment_gateway_id" {
+  description = "An existing payment gateway, a manual gateway is created when omitted"
+  type        = string
+  default     = null
+}
+
+variable "payment_source_type" {
+  description = "The payment source type of the payment method, which must match the payment gateway"
+  type        = string
+  default     = "WireTransfer"
+}
@@ -1,3 +1,10 @@
+resource "commercelayer_bing_geocoder" "incentro_bing_geocoder" {
+  attributes {
+    name = "Incentro Bing Geocoder"
+    key  = "Bing Virtualearth Key"
+  }
+}
+
 resource "commercelayer_address" "incentro_address" {
   attributes {
     business     = true
@@ -9,4 +16,13 @@ resource "commercelayer_address" "incentro_address" {
     phone        = "+31(0)10 20 20 544"
     state_code   = "ZH"
   }
+
+  relationships {
+    geocoder_id = commercelayer_bing_geocoder.incentro_bing_geocoder.id
+  }
+}
+
+# The geocoding results are read when the address is refreshed, so they are known from the next plan on
+output "incentro_address_coordinates" {
+  value = [commercelayer_address.incentro_address.geocoded_lat, commercelayer_address.incentro_address.geocoded_lng]
 }
@@ -4,4 +4,13 @@ resource "commercelayer_checkout_com_gateway" "incentro_checkout_com_gateway" {
     secret_key = "xxxx-yyyy-zzzz"
     public_key = "xxxx-yyyy-zzzz"
   }
-}
\ No newline at end of file
+}
+
+output "checkout_com_webhook_endpoint_url" {
+  value = commercelayer_checkout_com_gateway.incentro_checkout_com_gateway.webhook_endpoint_url
+}
+
+output "checkout_com_webhook_endpoint_secret" {
+  value     = commercelayer_checkout_com_gateway.incentro_checkout_com_gateway.webhook_endpoint_secret
+  sensitive = true
+}
@@ -0,0 +1,8 @@
+resource "commercelayer_cleanup" "incentro_cleanup" {
+  attributes {
+    resource_type = "skus"
+    filters = jsonencode({
+      code_start = "TSHIRT"
+    })
+  }
+}
@@ -0,0 +1,13 @@
+resource "commercelayer_export" "incentro_export" {
+  attributes {
+    resource_type = "skus"
+    format        = "csv"
+    filters = jsonencode({
+      code_start = "TSHIRT"
+    })
+  }
+}
+
+output "incentro_export_url" {
+  value = commercelayer_export.incentro_export.attachment_url
+}
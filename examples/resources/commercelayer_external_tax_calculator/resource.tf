@@ -3,4 +3,9 @@ resource "commercelayer_external_tax_calculator" "incentro_external_tax_calculat
     name               = "Incentro External Tax Calculator"
     tax_calculator_url = "https://example.com"
   }
+}
+
+output "incentro_external_tax_calculator_shared_secret" {
+  value     = commercelayer_external_tax_calculator.incentro_external_tax_calculator.shared_secret
+  sensitive = true
 }
\ No newline at end of file
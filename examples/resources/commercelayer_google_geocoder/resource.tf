@@ -1,4 +1,6 @@
 resource "commercelayer_google_geocoder" "incentro_google_geocoder" {
+  retain_on_destroy = true
+
   attributes {
     name    = "Incentro Google Geocoder"
     api_key = "Google Geocoder API Key"
@@ -0,0 +1,16 @@
+variable "customer_id" {
+  type        = string
+  description = "The id of the customer to notify, customers are not managed by the provider"
+}
+
+resource "commercelayer_in_stock_subscription" "incentro_in_stock_subscription" {
+  attributes {
+    stock_threshold = 5
+  }
+
+  relationships {
+    market_id   = commercelayer_market.incentro_market.id
+    customer_id = var.customer_id
+    sku_id      = commercelayer_sku.incentro_sku.id
+  }
+}
\ No newline at end of file
@@ -41,6 +41,7 @@ resource "commercelayer_market" "incentro_market" {
   attributes {
     name                          = "Incentro Market"
     facebook_pixel_id             = "pixel"
+    external_prices_url           = "https://prices.example.com"
     external_order_validation_url = "https://www.example.com"
   }
 
@@ -49,4 +50,9 @@ resource "commercelayer_market" "incentro_market" {
     merchant_id        = commercelayer_merchant.incentro_merchant.id
     price_list_id      = commercelayer_price_list.incentro_price_list.id
   }
+}
+
+output "incentro_market_shared_secret" {
+  value     = commercelayer_market.incentro_market.shared_secret
+  sensitive = true
 }
\ No newline at end of file
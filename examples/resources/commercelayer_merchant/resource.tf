@@ -20,4 +20,20 @@ resource "commercelayer_merchant" "incentro_merchant" {
   relationships {
     address_id = commercelayer_address.incentro_address.id
   }
-}
\ No newline at end of file
+}
+resource "commercelayer_merchant" "incentro_merchant_inline_address" {
+  attributes {
+    name = "Incentro Merchant"
+  }
+
+  address {
+    business     = true
+    company      = "Incentro"
+    line_1       = "Van Nelleweg 1"
+    zip_code     = "3044 BC"
+    country_code = "NL"
+    city         = "Rotterdam"
+    phone        = "+31(0)10 20 20 544"
+    state_code   = "ZH"
+  }
+}
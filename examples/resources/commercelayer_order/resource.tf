@@ -0,0 +1,16 @@
+resource "commercelayer_order" "incentro_seed_order" {
+  attributes {
+    customer_email = "seed@incentro.com"
+    language_code  = "en"
+    reference      = "e2e-seed"
+  }
+
+  line_item {
+    sku_code = "INCENTRO-TSHIRT-M"
+    quantity = 2
+  }
+
+  relationships {
+    market_id = commercelayer_market.incentro_market.id
+  }
+}
\ No newline at end of file
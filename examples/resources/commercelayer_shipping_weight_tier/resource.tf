@@ -0,0 +1,33 @@
+resource "commercelayer_shipping_method" "incentro_shipping_method" {
+  attributes {
+    name                   = "Incentro Shipping Method"
+    scheme                 = "weight_tiered"
+    currency_code          = "EUR"
+    price_amount_cents     = 1000
+    free_over_amount_cents = 10000
+    unit_of_weight         = "kg"
+  }
+}
+
+resource "commercelayer_shipping_weight_tier" "incentro_light_parcel" {
+  attributes {
+    name               = "Light parcel"
+    up_to              = 2
+    price_amount_cents = 500
+  }
+
+  relationships {
+    shipping_method_id = commercelayer_shipping_method.incentro_shipping_method.id
+  }
+}
+
+resource "commercelayer_shipping_weight_tier" "incentro_heavy_parcel" {
+  attributes {
+    name               = "Heavy parcel"
+    price_amount_cents = 1500
+  }
+
+  relationships {
+    shipping_method_id = commercelayer_shipping_method.incentro_shipping_method.id
+  }
+}
@@ -0,0 +1,19 @@
+resource "commercelayer_shipping_category" "incentro_shipping_category" {
+  attributes {
+    name = "Incentro Shipping Category"
+  }
+}
+
+resource "commercelayer_sku" "incentro_sku" {
+  attributes {
+    code             = "INCENTRO-TSHIRT-M"
+    name             = "Incentro T-shirt M"
+    weight           = 250
+    unit_of_weight   = "gr"
+    hs_tariff_number = "6109100010"
+  }
+
+  relationships {
+    shipping_category_id = commercelayer_shipping_category.incentro_shipping_category.id
+  }
+}
\ No newline at end of file
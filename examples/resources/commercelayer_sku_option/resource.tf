@@ -0,0 +1,9 @@
+resource "commercelayer_sku_option" "incentro_sku_option" {
+  attributes {
+    name               = "Incentro Engraving"
+    currency_code      = "EUR"
+    price_amount_cents = 500
+    delay_hours        = 48
+    sku_code_regex     = "^TSHIRT"
+  }
+}
\ No newline at end of file
@@ -22,4 +22,21 @@ resource "commercelayer_stock_location" "incentro_warehouse_location" {
   relationships {
     address_id = commercelayer_address.incentro_address.id
   }
+}
+
+resource "commercelayer_stock_location" "incentro_inline_address_location" {
+  attributes {
+    name = "Incentro Inline Address Stock Location"
+  }
+
+  address {
+    business     = true
+    company      = "Incentro Warehouse"
+    line_1       = "Van Nelleweg 1"
+    zip_code     = "3044 BC"
+    country_code = "NL"
+    city         = "Rotterdam"
+    phone        = "+31(0)10 20 20 544"
+    state_code   = "ZH"
+  }
 }
\ No newline at end of file
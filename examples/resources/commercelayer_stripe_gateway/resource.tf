@@ -3,4 +3,13 @@ resource "commercelayer_stripe_gateway" "incentro_stripe_gateway" {
     name  = "Incentro Stripe Gateway"
     login = "xxxx-yyyy-zzzz"
   }
-}
\ No newline at end of file
+}
+
+output "stripe_webhook_endpoint_url" {
+  value = commercelayer_stripe_gateway.incentro_stripe_gateway.webhook_endpoint_url
+}
+
+output "stripe_webhook_endpoint_secret" {
+  value     = commercelayer_stripe_gateway.incentro_stripe_gateway.webhook_endpoint_secret
+  sensitive = true
+}
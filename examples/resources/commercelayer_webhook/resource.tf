@@ -1,4 +1,7 @@
 resource "commercelayer_webhook" "incentro_webhook" {
+  # Changing this value resets the circuit breaker, e.g. after the callback has been fixed
+  reset_circuit_trigger = "INC-0001"
+
   attributes {
     name         = "Incentro Webhook"
     topic        = "orders.create"
@@ -9,3 +12,24 @@ resource "commercelayer_webhook" "incentro_webhook" {
     ]
   }
 }
+
+resource "commercelayer_webhook" "incentro_rotated_webhook" {
+  # Changing the rotation date replaces the webhook, which rotates its shared secret
+  keepers = {
+    rotated_at = "2023-01-01"
+  }
+
+  attributes {
+    topic        = "orders.place"
+    callback_url = "http://example.url"
+  }
+}
+
+output "incentro_rotated_webhook_shared_secret" {
+  value     = commercelayer_webhook.incentro_rotated_webhook.shared_secret
+  sensitive = true
+}
+
+output "incentro_webhook_circuit_state" {
+  value = commercelayer_webhook.incentro_webhook.circuit_state
+}
\ No newline at end of file
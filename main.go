@@ -1,19 +1,41 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
+	"os"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/incentro-dc/terraform-provider-commercelayer/commercelayer"
+	"github.com/incentro-dc/terraform-provider-commercelayer/drift"
 )
 
+// version is set by goreleaser at build time
+var version = "dev"
+
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
 func main() {
 	var debugMode bool
+	var driftReport string
+	var driftFormat string
 
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.StringVar(&driftReport, "drift-report", "", "path of a state file to check for resources removed or changed outside terraform, "+
+		"using the COMMERCELAYER_* environment variables for authentication")
+	flag.StringVar(&driftFormat, "drift-format", "json", "format of the drift report, json or markdown")
 	flag.Parse()
 
-	opts := &plugin.ServeOpts{ProviderFunc: commercelayer.Provider()}
+	if driftReport != "" {
+		if err := runDriftReport(driftReport, driftFormat); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	opts := &plugin.ServeOpts{ProviderFunc: commercelayer.Provider(commercelayer.WithVersion(version))}
 
 	if debugMode {
 		opts.Debug = true
@@ -22,3 +44,38 @@ func main() {
 
 	plugin.Serve(opts)
 }
+
+func runDriftReport(stateFile string, format string) error {
+	write := drift.WriteJSON
+	switch format {
+	case "json":
+	case "markdown":
+		write = drift.WriteMarkdown
+	default:
+		return fmt.Errorf("unsupported drift report format %s", format)
+	}
+
+	ctx := context.Background()
+
+	p := commercelayer.Provider(commercelayer.WithVersion(version))()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{})
+	if diags := p.Validate(config); diags.HasError() {
+		return fmt.Errorf("invalid provider configuration: %s", diags[0].Detail)
+	}
+	if diags := p.Configure(ctx, config); diags.HasError() {
+		return fmt.Errorf("failed to configure provider: %s", diags[0].Summary)
+	}
+
+	f, err := os.Open(stateFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	entries, err := drift.Report(ctx, p, f)
+	if err != nil {
+		return err
+	}
+
+	return write(os.Stdout, entries)
+}